@@ -52,14 +52,22 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTOrderComplete(w, r)
 	case strings.HasPrefix(path, "/ob/refund"):
 		i.POSTRefund(w, r)
+	case strings.HasPrefix(path, "/ob/adjustorder"):
+		i.POSTAdjustOrder(w, r)
 	case strings.HasPrefix(path, "/wallet/resyncblockchain"):
 		i.POSTResyncBlockchain(w, r)
 	case strings.HasPrefix(path, "/wallet/bumpfee"):
 		i.POSTBumpFee(w, r)
+	case strings.HasPrefix(path, "/wallet/txlabel"):
+		i.POSTTxLabel(w, r)
 	case strings.HasPrefix(path, "/ob/opendispute"):
 		i.POSTOpenDispute(w, r)
 	case strings.HasPrefix(path, "/ob/closedispute"):
 		i.POSTCloseDispute(w, r)
+	case strings.HasPrefix(path, "/ob/disputepreview"):
+		i.POSTDisputePreview(w, r)
+	case strings.HasPrefix(path, "/ob/endorsedispute"):
+		i.POSTEndorseDispute(w, r)
 	case strings.HasPrefix(path, "/ob/releasefunds"):
 		i.POSTReleaseFunds(w, r)
 	case strings.HasPrefix(path, "/ob/chat"):
@@ -681,12 +681,13 @@ func (i *jsonAPIHandler) POSTPurchase(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	type purchaseReturn struct {
-		PaymentAddress string `json:"paymentAddress"`
-		Amount         uint64 `json:"amount"`
-		VendorOnline   bool   `json:"vendorOnline"`
-		OrderId        string `json:"orderId"`
+		PaymentAddress    string `json:"paymentAddress"`
+		Amount            uint64 `json:"amount"`
+		VendorOnline      bool   `json:"vendorOnline"`
+		OrderId           string `json:"orderId"`
+		PendingSettlement bool   `json:"pendingSettlement"`
 	}
-	ret := purchaseReturn{paymentAddr, amount, online, orderId}
+	ret := purchaseReturn{paymentAddr, amount, online, orderId, data.BatchSettlement}
 	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -1206,12 +1207,27 @@ func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	slugs := make(map[string]bool)
 	for _, in := range invList {
 		err = i.node.Datastore.Inventory().Put(in.Slug, in.Variant, in.Quantity)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		slugs[in.Slug] = true
+	}
+	for slug := range slugs {
+		err = i.node.UpdateIndexInventory(slug)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	if len(slugs) > 0 {
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 	}
 	SanitizedResponse(w, `{}`)
 	return
@@ -1657,6 +1673,38 @@ func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 	return
 }
 
+func (i *jsonAPIHandler) POSTAdjustOrder(w http.ResponseWriter, r *http.Request) {
+	type orderAdjustment struct {
+		OrderId    string  `json:"orderId"`
+		Amount     uint64  `json:"amount"`
+		Percentage float32 `json:"percentage"`
+		Reason     string  `json:"reason"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var adj orderAdjustment
+	err := decoder.Decode(&adj)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	contract, state, funded, _, _, err := i.node.Datastore.Sales().GetByOrderId(adj.OrderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "order not found")
+		return
+	}
+	if funded || state != pb.OrderState_AWAITING_PAYMENT {
+		ErrorResponse(w, http.StatusBadRequest, core.ErrOrderAlreadyFunded.Error())
+		return
+	}
+	err = i.node.AdjustOrder(contract, state, funded, adj.Amount, adj.Percentage, adj.Reason)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+	return
+}
+
 func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query().Get("async")
 	async, _ := strconv.ParseBool(query)
@@ -1972,6 +2020,61 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 	return
 }
 
+func (i *jsonAPIHandler) POSTEndorseDispute(w http.ResponseWriter, r *http.Request) {
+	type endorsement struct {
+		OrderID string `json:"orderId"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var e endorsement
+	err := decoder.Decode(&e)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err = i.node.EndorseDisputeResolution(e.OrderID)
+	if err != nil && err == core.ErrCaseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+	return
+}
+
+func (i *jsonAPIHandler) POSTDisputePreview(w http.ResponseWriter, r *http.Request) {
+	type dispute struct {
+		OrderID          string  `json:"orderId"`
+		BuyerPercentage  float32 `json:"buyerPercentage"`
+		VendorPercentage float32 `json:"vendorPercentage"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var d dispute
+	err := decoder.Decode(&d)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	preview, err := i.node.PreviewDisputePayout(d.OrderID, d.BuyerPercentage, d.VendorPercentage)
+	if err != nil && err == core.ErrCaseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(preview, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+	return
+}
+
 func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 	_, orderId := path.Split(r.URL.Path)
 	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderId)
@@ -2553,6 +2656,7 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 		OrderId       string    `json:"orderId"`
 		Thumbnail     string    `json:"thumbnail"`
 		CanBumpFee    bool      `json:"canBumpFee"`
+		Label         string    `json:"label"`
 	}
 	transactions, err := i.node.Wallet.Transactions()
 	if err != nil {
@@ -2605,6 +2709,7 @@ func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request)
 			tx.OrderId = m.OrderId
 			tx.Thumbnail = m.Thumbnail
 			tx.CanBumpFee = m.CanBumpFee
+			tx.Label = m.Label
 		}
 		if status == "DEAD" {
 			tx.CanBumpFee = false
@@ -2911,6 +3016,25 @@ func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, `{}`)
 }
 
+func (i *jsonAPIHandler) POSTTxLabel(w http.ResponseWriter, r *http.Request) {
+	type txLabel struct {
+		Txid  string `json:"txid"`
+		Label string `json:"label"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var l txLabel
+	err := decoder.Decode(&l)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.SetTxLabel(l.Txid, l.Label); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
 func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
 	_, txid := path.Split(r.URL.Path)
 	txHash, err := chainhash.NewHashFromStr(txid)
@@ -183,7 +183,8 @@ const profileJSON = `{
 	    "followingCount": 2,
 	    "listingCount": 3,
 	    "ratingCount": 21000000,
-	    "averageRating": 1
+	    "averageRating": 1,
+	    "typicalResponseTime": 0
     },
     "bitcoinPubkey": "0314e6def3bd71e2806d87ae06ec88ca175701b34ae308f81c16266f69ddc98053"
 }`
@@ -54,6 +54,10 @@ type orderConfirmationWrapper struct {
 	OrderConfirmationNotification `json:"orderConfirmation"`
 }
 
+type orderAdjustmentWrapper struct {
+	OrderAdjustmentNotification `json:"orderAdjustment"`
+}
+
 type orderCancelWrapper struct {
 	OrderCancelNotification `json:"orderCancel"`
 }
@@ -82,6 +86,22 @@ type disputeCloseWrapper struct {
 	DisputeCloseNotification `json:"disputeClose"`
 }
 
+type disputeProposalWrapper struct {
+	DisputeProposalNotification `json:"disputeProposal"`
+}
+
+type partialPaymentWrapper struct {
+	PartialPaymentNotification `json:"partialPayment"`
+}
+
+type settlementWrapper struct {
+	SettlementNotification `json:"settlement"`
+}
+
+type settlementFailedWrapper struct {
+	SettlementFailedNotification `json:"settlementFailed"`
+}
+
 type OrderNotification struct {
 	Title             string `json:"title"`
 	BuyerId           string `json:"buyerId"`
@@ -100,6 +120,12 @@ type OrderConfirmationNotification struct {
 	OrderId string `json:"orderId"`
 }
 
+type OrderAdjustmentNotification struct {
+	OrderId   string `json:"orderId"`
+	NewAmount uint64 `json:"newAmount"`
+	Reason    string `json:"reason"`
+}
+
 type OrderCancelNotification struct {
 	OrderId string `json:"orderId"`
 }
@@ -128,6 +154,30 @@ type DisputeCloseNotification struct {
 	OrderId string `json:"orderId"`
 }
 
+type DisputeProposalNotification struct {
+	OrderId    string `json:"orderId"`
+	ProposedBy string `json:"proposedBy"`
+}
+
+type PartialPaymentNotification struct {
+	OrderId         string `json:"orderId"`
+	FundingTotal    uint64 `json:"fundingTotal"`
+	RequestedAmount uint64 `json:"requestedAmount"`
+}
+
+type SettlementNotification struct {
+	Txid     string   `json:"txid"`
+	VendorId string   `json:"vendorId"`
+	OrderIds []string `json:"orderIds"`
+	Amount   uint64   `json:"amount"`
+}
+
+type SettlementFailedNotification struct {
+	VendorId string   `json:"vendorId"`
+	OrderIds []string `json:"orderIds"`
+	Reason   string   `json:"reason"`
+}
+
 type FollowNotification struct {
 	Follow string `json:"follow"`
 }
@@ -189,6 +239,8 @@ func Wrap(i interface{}) interface{} {
 		return paymentWrapper{PaymentNotification: i.(PaymentNotification)}
 	case OrderConfirmationNotification:
 		return orderConfirmationWrapper{OrderConfirmationNotification: i.(OrderConfirmationNotification)}
+	case OrderAdjustmentNotification:
+		return orderAdjustmentWrapper{OrderAdjustmentNotification: i.(OrderAdjustmentNotification)}
 	case OrderCancelNotification:
 		return orderCancelWrapper{OrderCancelNotification: i.(OrderCancelNotification)}
 	case RefundNotification:
@@ -203,6 +255,14 @@ func Wrap(i interface{}) interface{} {
 		return disputeUpdateWrapper{DisputeUpdateNotification: i.(DisputeUpdateNotification)}
 	case DisputeCloseNotification:
 		return disputeCloseWrapper{DisputeCloseNotification: i.(DisputeCloseNotification)}
+	case DisputeProposalNotification:
+		return disputeProposalWrapper{DisputeProposalNotification: i.(DisputeProposalNotification)}
+	case PartialPaymentNotification:
+		return partialPaymentWrapper{PartialPaymentNotification: i.(PartialPaymentNotification)}
+	case SettlementNotification:
+		return settlementWrapper{SettlementNotification: i.(SettlementNotification)}
+	case SettlementFailedNotification:
+		return settlementFailedWrapper{SettlementFailedNotification: i.(SettlementFailedNotification)}
 	default:
 		return i
 	}
@@ -216,6 +276,8 @@ func wrapType(i interface{}) interface{} {
 		return notificationWrapper{i}
 	case orderConfirmationWrapper:
 		return notificationWrapper{i}
+	case orderAdjustmentWrapper:
+		return notificationWrapper{i}
 	case orderCancelWrapper:
 		return notificationWrapper{i}
 	case refundWrapper:
@@ -230,6 +292,14 @@ func wrapType(i interface{}) interface{} {
 		return notificationWrapper{i}
 	case disputeCloseWrapper:
 		return notificationWrapper{i}
+	case disputeProposalWrapper:
+		return notificationWrapper{i}
+	case partialPaymentWrapper:
+		return notificationWrapper{i}
+	case settlementWrapper:
+		return notificationWrapper{i}
+	case settlementFailedWrapper:
+		return notificationWrapper{i}
 	case FollowNotification:
 		return notificationWrapper{i}
 	case UnfollowNotification:
@@ -290,6 +360,13 @@ func Describe(i interface{}) (string, string) {
 		form := "Order \"%s\" has been confirmed."
 		body = fmt.Sprintf(form, n.OrderId)
 
+	case OrderAdjustmentNotification:
+		head = "Order discounted"
+
+		n := i.(OrderAdjustmentNotification)
+		form := "The vendor has lowered the total of order \"%s\"."
+		body = fmt.Sprintf(form, n.OrderId)
+
 	case OrderCancelNotification:
 		head = "Order cancelled"
 
@@ -338,6 +415,34 @@ func Describe(i interface{}) (string, string) {
 		n := i.(DisputeCloseNotification)
 		form := "Dispute around order \"%s\" was closed."
 		body = fmt.Sprintf(form, n.OrderId)
+
+	case DisputeProposalNotification:
+		head = "Dispute resolution proposed"
+
+		n := i.(DisputeProposalNotification)
+		form := "The lead moderator proposed a resolution for the dispute around order \"%s\". Endorse it to release the funds."
+		body = fmt.Sprintf(form, n.OrderId)
+
+	case PartialPaymentNotification:
+		head = "Order partially paid"
+
+		n := i.(PartialPaymentNotification)
+		form := "Order \"%s\" only received %d of the %d requested. It needs to be completed or refunded by hand."
+		body = fmt.Sprintf(form, n.OrderId, n.FundingTotal, n.RequestedAmount)
+
+	case SettlementNotification:
+		head = "Orders settled"
+
+		n := i.(SettlementNotification)
+		form := "%d orders have been paid in a single transaction."
+		body = fmt.Sprintf(form, len(n.OrderIds))
+
+	case SettlementFailedNotification:
+		head = "Settlement failed"
+
+		n := i.(SettlementFailedNotification)
+		form := "%d batched orders could not be paid (%s). They will be tried again with the next settlement, or you can pay for them individually."
+		body = fmt.Sprintf(form, len(n.OrderIds), n.Reason)
 	}
 	return head, body
 }
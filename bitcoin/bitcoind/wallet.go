@@ -273,6 +273,22 @@ func (w *BitcoindWallet) Spend(amount int64, addr btc.Address, feeLevel spvwalle
 	return w.rpcClient.SendFrom(Account, addr, amt)
 }
 
+func (w *BitcoindWallet) SpendMany(outs []spvwallet.TransactionOutput, feeLevel spvwallet.FeeLevel) (*chainhash.Hash, error) {
+	amounts := make(map[btc.Address]btc.Amount)
+	for _, o := range outs {
+		addr, err := w.ScriptToAddress(o.ScriptPubKey)
+		if err != nil {
+			return nil, err
+		}
+		amt, err := btc.NewAmount(float64(o.Value) / 100000000)
+		if err != nil {
+			return nil, err
+		}
+		amounts[addr] += amt
+	}
+	return w.rpcClient.SendMany(Account, amounts)
+}
+
 func (w *BitcoindWallet) BumpFee(txid chainhash.Hash) (*chainhash.Hash, error) {
 	includeWatchOnly := false
 	tx, err := w.rpcClient.GetTransaction(&txid, &includeWatchOnly)
@@ -471,6 +487,57 @@ func (w *BitcoindWallet) Multisign(ins []spvwallet.TransactionInput, outs []spvw
 	return buf.Bytes(), nil
 }
 
+func (w *BitcoindWallet) MultisignScripts(ins []spvwallet.TransactionInput, outs []spvwallet.TransactionOutput, sigScripts [][]byte, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error) {
+	if len(sigScripts) != len(ins) {
+		return nil, errors.New("Need one signature script per input")
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	for _, in := range ins {
+		ch, err := chainhash.NewHashFromStr(hex.EncodeToString(in.OutpointHash))
+		if err != nil {
+			return nil, err
+		}
+		outpoint := wire.NewOutPoint(ch, in.OutpointIndex)
+		input := wire.NewTxIn(outpoint, []byte{})
+		tx.TxIn = append(tx.TxIn, input)
+	}
+	for _, out := range outs {
+		output := wire.NewTxOut(out.Value, out.ScriptPubKey)
+		tx.TxOut = append(tx.TxOut, output)
+	}
+
+	// Subtract fee
+	estimatedSize := spvwallet.EstimateSerializeSize(len(ins), tx.TxOut, false)
+	fee := estimatedSize * int(feePerByte)
+	feePerOutput := fee / len(tx.TxOut)
+	for _, output := range tx.TxOut {
+		output.Value -= int64(feePerOutput)
+	}
+
+	// BIP 69 sorting
+	txsort.InPlaceSort(tx)
+
+	for i, input := range tx.TxIn {
+		builder := txscript.NewScriptBuilder()
+		builder.AddData(redeemScript)
+		push, err := builder.Script()
+		if err != nil {
+			return nil, err
+		}
+		input.SignatureScript = append(append([]byte{}, sigScripts[i]...), push...)
+	}
+	// Broadcast
+	if broadcast {
+		_, err := w.rpcClient.SendRawTransaction(tx, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var buf bytes.Buffer
+	tx.BtcEncode(&buf, 1)
+	return buf.Bytes(), nil
+}
+
 func (w *BitcoindWallet) SweepAddress(utxos []spvwallet.Utxo, address *btc.Address, key *hd.ExtendedKey, redeemScript *[]byte, feeLevel spvwallet.FeeLevel) (*chainhash.Hash, error) {
 	var internalAddr btc.Address
 	if address != nil {
@@ -20,14 +20,15 @@ import (
 var log = logging.MustGetLogger("transaction-listener")
 
 type TransactionListener struct {
-	db        repo.Datastore
-	broadcast chan interface{}
-	wallet    bitcoin.BitcoinWallet
+	db               repo.Datastore
+	broadcast        chan interface{}
+	wallet           bitcoin.BitcoinWallet
+	inventoryChanged func(slug string) error
 	*sync.Mutex
 }
 
-func NewTransactionListener(db repo.Datastore, broadcast chan interface{}, wallet bitcoin.BitcoinWallet) *TransactionListener {
-	l := &TransactionListener{db, broadcast, wallet, new(sync.Mutex)}
+func NewTransactionListener(db repo.Datastore, broadcast chan interface{}, wallet bitcoin.BitcoinWallet, inventoryChanged func(slug string) error) *TransactionListener {
+	l := &TransactionListener{db, broadcast, wallet, inventoryChanged, new(sync.Mutex)}
 	return l
 }
 
@@ -95,6 +96,7 @@ func (l *TransactionListener) OnTransactionReceived(cb spvwallet.TransactionCall
 			ScriptPubKey: hex.EncodeToString(input.LinkedScriptPubKey),
 		}
 		records = append(records, record)
+		l.db.TxMetadata().PutGeneratedLabel(chainHash.String(), "Funds released from order "+orderId)
 		if isForSale {
 			l.db.Sales().UpdateFunding(orderId, funded, records)
 			// This is a dispute payout. We should set the order state.
@@ -129,14 +131,14 @@ func (l *TransactionListener) processSalePayment(txid []byte, output spvwallet.T
 		return
 	}
 	if !funded {
-		requestedAmount := int64(contract.BuyerOrder.Payment.Amount)
+		requestedAmount := int64(repo.RequestedAmount(contract))
 		if funding >= requestedAmount {
 			log.Debugf("Recieved payment for order %s", orderId)
 			funded = true
 
-			if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation != nil { // Confirmed orders go to AWAITING_FULFILLMENT
+			if awaitingPayment(state) && contract.VendorOrderConfirmation != nil { // Confirmed orders go to AWAITING_FULFILLMENT
 				l.db.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
-			} else if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation == nil { // Unconfirmed orders go into PENDING
+			} else if awaitingPayment(state) && contract.VendorOrderConfirmation == nil { // Unconfirmed orders go into PENDING
 				l.db.Sales().Put(orderId, *contract, pb.OrderState_PENDING, false)
 			}
 			l.adjustInventory(contract)
@@ -150,6 +152,10 @@ func (l *TransactionListener) processSalePayment(txid []byte, output spvwallet.T
 				orderId,
 			}
 
+			l.broadcast <- n
+			l.db.Notifications().Put(notifications.Wrap(n), time.Now())
+		} else if state == pb.OrderState_EXPIRED {
+			n := notifications.PartialPaymentNotification{orderId, uint64(funding), uint64(requestedAmount)}
 			l.broadcast <- n
 			l.db.Notifications().Put(notifications.Wrap(n), time.Now())
 		}
@@ -176,7 +182,8 @@ func (l *TransactionListener) processSalePayment(txid []byte, output spvwallet.T
 	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
 		bumpable = true
 	}
-	l.db.TxMetadata().Put(repo.Metadata{chainHash.String(), "", title, orderId, thumbnail, bumpable})
+	l.db.TxMetadata().Put(repo.Metadata{chainHash.String(), "", title, orderId, thumbnail, bumpable, ""})
+	l.db.TxMetadata().PutGeneratedLabel(chainHash.String(), "Payment received for order "+orderId)
 }
 
 func (l *TransactionListener) processPurchasePayment(txid []byte, output spvwallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*spvwallet.TransactionRecord) {
@@ -196,14 +203,19 @@ func (l *TransactionListener) processPurchasePayment(txid []byte, output spvwall
 	if err != nil {
 		return
 	}
+	// The order was paid before its batch was settled
+	if state == pb.OrderState_PENDING_SETTLEMENT {
+		state = pb.OrderState_AWAITING_PAYMENT
+		l.db.Purchases().Put(orderId, *contract, state, false)
+	}
 	if !funded {
-		requestedAmount := int64(contract.BuyerOrder.Payment.Amount)
+		requestedAmount := int64(repo.RequestedAmount(contract))
 		if funding >= requestedAmount {
 			log.Debugf("Payment for purchase %s detected", orderId)
 			funded = true
-			if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation != nil { // Confirmed orders go to AWAITING_FULFILLMENT
+			if awaitingPayment(state) && contract.VendorOrderConfirmation != nil { // Confirmed orders go to AWAITING_FULFILLMENT
 				l.db.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
-			} else if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation == nil { // Unconfirmed go into PENDING
+			} else if awaitingPayment(state) && contract.VendorOrderConfirmation == nil { // Unconfirmed go into PENDING
 				l.db.Purchases().Put(orderId, *contract, pb.OrderState_PENDING, false)
 			}
 		}
@@ -224,6 +236,13 @@ func (l *TransactionListener) processPurchasePayment(txid []byte, output spvwall
 	}
 	records = append(records, record)
 	l.db.Purchases().UpdateFunding(orderId, funded, records)
+	l.db.TxMetadata().PutGeneratedLabel(chainHash.String(), "Payment for order "+orderId)
+}
+
+// Whether a payment which funds an order in this state moves it on. An order which expired
+// while unpaid is processed as usual once fully paid, a partial payment leaves it expired.
+func awaitingPayment(state pb.OrderState) bool {
+	return state == pb.OrderState_AWAITING_PAYMENT || state == pb.OrderState_EXPIRED
 }
 
 func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
@@ -252,6 +271,9 @@ func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
 		}
 		l.db.Inventory().Put(listing.Slug, variant, c-q)
 		log.Debugf("Adjusting inventory for %s:%d to %d\n", listing.Slug, variant, c-q)
+		if err := l.inventoryChanged(listing.Slug); err != nil {
+			log.Errorf("Error updating public inventory for %s: %s", listing.Slug, err.Error())
+		}
 	}
 }
 
@@ -76,6 +76,9 @@ type BitcoinWallet interface {
 	// Combine signatures and optionally broadcast
 	Multisign(ins []spvwallet.TransactionInput, outs []spvwallet.TransactionOutput, sigs1 []spvwallet.Signature, sigs2 []spvwallet.Signature, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error)
 
+	// Combine signatures for p2sh scripts other than a plain multisig. There must be one signature script for each input
+	MultisignScripts(ins []spvwallet.TransactionInput, outs []spvwallet.TransactionOutput, sigScripts [][]byte, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error)
+
 	// Generate a multisig script from public keys
 	GenerateMultisigScript(keys []hd.ExtendedKey, threshold int) (addr btc.Address, redeemScript []byte, err error)
 
@@ -94,3 +97,19 @@ type BitcoinWallet interface {
 	// Cleanly disconnect from the wallet
 	Close()
 }
+
+// Wallets which derive their addresses from an HD keychain may implement this
+// interface to let callers record where a fresh address came from.
+type HDWallet interface {
+
+	// Get a new address for the given purpose along with its derivation index
+	NewAddressWithIndex(purpose spvwallet.KeyPurpose) (btc.Address, uint32, error)
+}
+
+// Wallets which can pay several outputs in one transaction may implement this
+// interface so payments can be batched to save on fees.
+type BatchWallet interface {
+
+	// Send bitcoins to each output in a single transaction
+	SpendMany(outs []spvwallet.TransactionOutput, feeLevel spvwallet.FeeLevel) (*chainhash.Hash, error)
+}
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var ErrOrderAlreadyFunded = errors.New("Order has already been funded and can no longer be adjusted")
+
+// Returns the payment address for the order
+func PaymentAddress(contract *pb.RicardianContract) string {
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST && contract.VendorOrderConfirmation != nil {
+		return contract.VendorOrderConfirmation.PaymentAddress
+	}
+	return contract.BuyerOrder.Payment.Address
+}
+
+/* Reduce the total of an unpaid order by either a fixed amount (in satoshi) or a
+   percentage of the current total. The adjustment is signed, appended to the contract
+   and sent to the buyer as a new payment request. */
+func (n *OpenBazaarNode) AdjustOrder(contract *pb.RicardianContract, state pb.OrderState, funded bool, amount uint64, percentage float32, reason string) error {
+	if funded || state != pb.OrderState_AWAITING_PAYMENT {
+		return ErrOrderAlreadyFunded
+	}
+	if (amount == 0) == (percentage == 0) {
+		return errors.New("Either an amount or a percentage must be specified")
+	}
+	if percentage < 0 || percentage >= 100 {
+		return errors.New("Percentage must be between 0 and 100")
+	}
+
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	previous := repo.RequestedAmount(contract)
+	discount := amount
+	if percentage > 0 {
+		discount = uint64(float64(previous) * float64(percentage) / 100)
+	}
+	if discount == 0 || discount >= previous {
+		return errors.New("Discount must be greater than zero and less than the order total")
+	}
+
+	adjustment := new(pb.OrderAdjustment)
+	adjustment.OrderID = orderId
+	adjustment.PreviousAmount = previous
+	adjustment.NewAmount = previous - discount
+	adjustment.PaymentAddress = PaymentAddress(contract)
+	adjustment.Reason = reason
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	adjustment.Timestamp = ts
+
+	contract.VendorOrderAdjustments = append(contract.VendorOrderAdjustments, adjustment)
+	contract, err = n.SignOrderAdjustment(contract)
+	if err != nil {
+		return err
+	}
+	err = n.SendOrderAdjustment(contract.BuyerOrder.BuyerID.PeerID, contract)
+	if err != nil {
+		return err
+	}
+	return n.Datastore.Sales().Put(orderId, *contract, state, true)
+}
+
+func (n *OpenBazaarNode) SignOrderAdjustment(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	adjustment := contract.VendorOrderAdjustments[len(contract.VendorOrderAdjustments)-1]
+	serializedAdjustment, err := proto.Marshal(adjustment)
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_ORDER_ADJUSTMENT
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedAdjustment)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+/* Validate the most recent adjustment on the vendor's contract against the order we
+   have saved. The adjustment must lower the total we were last asked to pay and must
+   be signed by the vendor. */
+func (n *OpenBazaarNode) ValidateOrderAdjustment(vendorContract, contract *pb.RicardianContract) error {
+	if len(vendorContract.VendorOrderAdjustments) == 0 {
+		return errors.New("Contract does not contain an order adjustment")
+	}
+	adjustment := vendorContract.VendorOrderAdjustments[len(vendorContract.VendorOrderAdjustments)-1]
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	if adjustment.OrderID != orderId {
+		return errors.New("Vendor's order adjustment contained invalid order ID")
+	}
+	if adjustment.PreviousAmount != repo.RequestedAmount(contract) {
+		return errors.New("Vendor's order adjustment does not match the current order total")
+	}
+	if adjustment.NewAmount == 0 || adjustment.NewAmount >= adjustment.PreviousAmount {
+		return errors.New("Order adjustments may only lower the order total")
+	}
+	if adjustment.PaymentAddress != PaymentAddress(contract) {
+		return errors.New("Vendor's order adjustment contained a different payment address")
+	}
+	if err := verifyMessageSignature(
+		adjustment,
+		contract.VendorListings[0].VendorID.Pubkeys.Identity,
+		vendorContract.Signatures,
+		pb.Signature_ORDER_ADJUSTMENT,
+		contract.VendorListings[0].VendorID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("Contract does not contain a signature for the order adjustment")
+		case invalidSigError:
+			return errors.New("Vendor's guid signature on contact failed to verify")
+		case matchKeyError:
+			return errors.New("Public key in order adjustment does not match reported vendor ID")
+		default:
+			return err
+		}
+	}
+	return nil
+}
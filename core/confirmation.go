@@ -26,8 +26,9 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 	}
 	oc.OrderID = orderID
 	if addressRequest {
-		addr := n.Wallet.NewAddress(spvwallet.EXTERNAL)
+		addr, derivation := n.NewPaymentAddress()
 		oc.PaymentAddress = addr.EncodeAddress()
+		oc.PaymentAddressDerivation = derivation
 	}
 
 	ts, err := ptypes.TimestampProto(time.Now())
@@ -69,6 +70,10 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 	if err != nil {
 		return nil, err
 	}
+	oc.Items, err = ItemSnapshots(contract)
+	if err != nil {
+		return nil, err
+	}
 	contract.VendorOrderConfirmation = oc
 	contract, err = n.SignOrderConfirmation(contract)
 	if err != nil {
@@ -144,7 +149,7 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, rec
 		return err
 	}
 	n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
-	return nil
+	return n.recordResponseTime(contract, contract.VendorOrderConfirmation.OrderID)
 }
 
 func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, records []*spvwallet.TransactionRecord) error {
@@ -233,6 +238,7 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 		return err
 	}
 	n.Datastore.Sales().Put(orderId, *contract, pb.OrderState_DECLINED, true)
+	n.UnprotectPeer(contract.BuyerOrder.BuyerID.PeerID, OrderProtectTag(orderId))
 	return nil
 }
 
@@ -247,6 +253,9 @@ func (n *OpenBazaarNode) ValidateOrderConfirmation(contract *pb.RicardianContrac
 	if contract.VendorOrderConfirmation.RequestedAmount != contract.BuyerOrder.Payment.Amount {
 		return errors.New("Vendor requested an amount different from what we calculated")
 	}
+	if err := validateItemSnapshots(contract); err != nil {
+		return err
+	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		for _, sig := range contract.VendorOrderConfirmation.RatingSignatures {
 			exists := false
@@ -335,6 +344,10 @@ func verifySignaturesOnOrderConfirmation(contract *pb.RicardianContract) error {
 	return nil
 }
 
+// Returns the moderator's key from the escrow redeem script. For a panel this is the lead moderator.
 func ExtraModeratorKeyFromReddemScript(redeemScript string) string {
+	if key, ok := panelLeadKey(redeemScript); ok {
+		return key
+	}
 	return redeemScript[134:200]
 }
@@ -76,10 +76,12 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 	contract.Dispute = dispute
 	contract.Signatures = append(contract.Signatures, rc.Signatures[0])
 
-	// Send to moderator
-	err = n.SendDisputeOpen(contract.BuyerOrder.Payment.Moderator, nil, rc)
-	if err != nil {
-		return err
+	// Send to moderator, or to everyone on the panel
+	for _, mod := range OrderModerators(contract.BuyerOrder.Payment) {
+		err = n.SendDisputeOpen(mod, nil, rc)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Send to counterparty
@@ -108,6 +110,7 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 		n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_DISPUTED, true)
 	} else {
 		n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_DISPUTED, true)
+		return n.ExcludeResponseTime(orderID)
 	}
 	return nil
 }
@@ -194,7 +197,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 	}
 
 	// Figure out what role we have in this dispute and process it
-	if contract.BuyerOrder.Payment.Moderator == n.IpfsNode.Identity.Pretty() { // Moderator
+	if isOrderModerator(contract.BuyerOrder.Payment, n.IpfsNode.Identity.Pretty()) { // Moderator
 		validationErrors := n.ValidateCaseContract(contract)
 		var err error
 		if contract.VendorListings[0].VendorID.PeerID == peerID {
@@ -253,9 +256,11 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		for _, mod := range OrderModerators(myContract.BuyerOrder.Payment) {
+			err = n.SendDisputeUpdate(mod, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
@@ -270,6 +275,9 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		if err != nil {
 			return err
 		}
+		if err := n.ExcludeResponseTime(orderId); err != nil {
+			return err
+		}
 	} else if contract.BuyerOrder.BuyerID.PeerID == n.IpfsNode.Identity.Pretty() { // Buyer
 		// Load out version of the contract from the db
 		myContract, state, _, records, _, err := n.Datastore.Purchases().GetByOrderId(orderId)
@@ -302,9 +310,11 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		for _, mod := range OrderModerators(myContract.BuyerOrder.Payment) {
+			err = n.SendDisputeUpdate(mod, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
@@ -343,6 +353,24 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		return errors.New("A dispute for this order is not open")
 	}
 
+	// Pay what PreviewDisputePayout showed, with the restocking fee taken from the buyer's share
+	split, err := disputePayout(buyerContract, vendorContract, buyerOutpoints, vendorOutpoints, buyerPercentage, vendorPercentage, n.GetModeratorFee)
+	if err != nil {
+		return err
+	}
+	buyerPercentage, vendorPercentage = split.BuyerPercentage, split.VendorPercentage
+
+	// Only the lead moderator of a panel proposes a resolution. The co-moderators endorse it.
+	var payment *pb.Order_Payment
+	if buyerContract != nil {
+		payment = buyerContract.BuyerOrder.Payment
+	} else if vendorContract != nil {
+		payment = vendorContract.BuyerOrder.Payment
+	}
+	if IsPanel(payment) && payment.Moderator != n.IpfsNode.Identity.Pretty() {
+		return ErrNotLeadModerator
+	}
+
 	d := new(pb.DisputeResolution)
 
 	// Add timestamp
@@ -467,15 +495,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	}
 
 	// Create outputs using full value. We will subtract the fee off each output later.
+	// On a panel the moderator fee is the lead moderator's and is paid to the lead.
 	var outputs []spvwallet.TransactionOutput
 	var modAddr btcutil.Address
-	var modValue uint64
 	modAddr = n.Wallet.CurrentAddress(spvwallet.EXTERNAL)
-	modValue, err = n.GetModeratorFee(totalOut)
+	modValue := split.ModeratorFee
 	var modOutputScript []byte
-	if err != nil {
-		return err
-	}
 	if modValue > 0 {
 		modOutputScript, err = n.Wallet.AddressToScript(modAddr)
 		if err != nil {
@@ -497,7 +522,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		if err != nil {
 			return err
 		}
-		buyerValue = uint64((float64(totalOut) - float64(modValue)) * (float64(buyerPercentage) / 100))
+		buyerValue = split.BuyerAmount
 		buyerOutputScript, err = n.Wallet.AddressToScript(buyerAddr)
 		if err != nil {
 			return err
@@ -516,7 +541,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		if err != nil {
 			return err
 		}
-		vendorValue = uint64((float64(totalOut) - float64(modValue)) * (float64(vendorPercentage) / 100))
+		vendorValue = split.VendorAmount
 		vendorOutputScript, err = n.Wallet.AddressToScript(vendorAddr)
 		if err != nil {
 			return err
@@ -645,6 +670,14 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	if err != nil {
 		return err
 	}
+	if IsPanel(payment) {
+		for _, mod := range payment.ModeratorPanel[1:] {
+			err = n.SendDisputeClose(mod, nil, rc)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
 	err = n.Datastore.Cases().MarkAsClosed(orderId, d)
 	if err != nil {
@@ -861,6 +894,9 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
+		if IsPanel(contract.BuyerOrder.Payment) {
+			return append(validationErrors, n.validatePanelCaseScript(contract.BuyerOrder.Payment, buyerKey, vendorKey, moderatorKey)...)
+		}
 		addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2)
 
 		if contract.BuyerOrder.Payment.Address != addr.EncodeAddress() {
@@ -946,58 +982,14 @@ func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.Ricardi
 }
 
 func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []*spvwallet.TransactionRecord) error {
-	// Create inputs
-	var inputs []spvwallet.TransactionInput
-	for _, o := range contract.DisputeResolution.Payout.Inputs {
-		decodedHash, err := hex.DecodeString(o.Hash)
-		if err != nil {
-			return err
-		}
-		input := spvwallet.TransactionInput{
-			OutpointHash:  decodedHash,
-			OutpointIndex: o.Index,
-		}
-		inputs = append(inputs, input)
+	if !PanelApproved(contract) {
+		return errors.New("Not enough panel moderators have endorsed the dispute resolution")
 	}
 
-	if len(inputs) == 0 {
-		return errors.New("Transaction has no inputs")
-	}
-
-	// Create outputs
-	var outputs []spvwallet.TransactionOutput
-	if contract.DisputeResolution.Payout.BuyerOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.BuyerOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := spvwallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.BuyerOutput.Amount),
-		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.VendorOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.VendorOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := spvwallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.VendorOutput.Amount),
-		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.ModeratorOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.ModeratorOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := spvwallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.ModeratorOutput.Amount),
-		}
-		outputs = append(outputs, output)
+	// Create inputs and outputs
+	inputs, outputs, err := disputePayoutTx(contract.DisputeResolution.Payout)
+	if err != nil {
+		return err
 	}
 
 	// Create signing key
@@ -1047,6 +1039,15 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 		moderatorSigs = append(moderatorSigs, s)
 	}
 
+	if IsPanel(contract.BuyerOrder.Payment) {
+		sigScripts, err := panelSigScripts(contract, mySigs, len(inputs))
+		if err != nil {
+			return err
+		}
+		_, err = n.Wallet.MultisignScripts(inputs, outputs, sigScripts, redeemScriptBytes, 0, true)
+		return err
+	}
+
 	_, err = n.Wallet.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0, true)
 	if err != nil {
 		return err
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/spvwallet"
+)
+
+const expiryInterval = time.Hour
+
+/* Moves orders which have been awaiting payment for longer than maxAge into the EXPIRED
+   state so abandoned orders stop cluttering the open sales and purchases. Expired orders
+   stay in the database for the order history. Orders which received part of their payment
+   are never expired, a notification is sent once instead so they can be handled by hand.
+   A payment which fully funds an expired order later on is processed as if it had arrived
+   in time. A partial one leaves the order expired. */
+type OrderExpirer struct {
+	db        repo.Datastore
+	broadcast chan interface{}
+	maxAge    time.Duration
+	flagged   map[string]bool
+}
+
+func NewOrderExpirer(db repo.Datastore, broadcast chan interface{}, maxAge time.Duration) *OrderExpirer {
+	return &OrderExpirer{db, broadcast, maxAge, make(map[string]bool)}
+}
+
+func (e *OrderExpirer) Run() {
+	if e.maxAge <= 0 {
+		return
+	}
+	tick := time.NewTicker(expiryInterval)
+	defer tick.Stop()
+	e.ExpireOrders(time.Now())
+	for range tick.C {
+		e.ExpireOrders(time.Now())
+	}
+}
+
+// The part of the sales and purchases stores used to expire orders
+type unpaidOrders interface {
+	GetUnpaid(placedBefore time.Time) ([]string, error)
+	GetByOrderId(orderId string) (*pb.RicardianContract, pb.OrderState, bool, []*spvwallet.TransactionRecord, bool, error)
+	Put(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool) error
+}
+
+func (e *OrderExpirer) ExpireOrders(now time.Time) {
+	e.expire(e.db.Sales(), now)
+	e.expire(e.db.Purchases(), now)
+}
+
+func (e *OrderExpirer) expire(orders unpaidOrders, now time.Time) {
+	orderIds, err := orders.GetUnpaid(now.Add(-e.maxAge))
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, orderId := range orderIds {
+		contract, state, funded, records, read, err := orders.GetByOrderId(orderId)
+		if err != nil || funded || state != pb.OrderState_AWAITING_PAYMENT {
+			continue
+		}
+		if received := fundingReceived(records); received > 0 {
+			if !e.flagged[orderId] {
+				e.flagged[orderId] = true
+				n := notifications.PartialPaymentNotification{orderId, received, repo.RequestedAmount(contract)}
+				e.broadcast <- n
+				e.db.Notifications().Put(notifications.Wrap(n), now)
+			}
+			continue
+		}
+		if err := orders.Put(orderId, *contract, pb.OrderState_EXPIRED, read); err != nil {
+			log.Error(err)
+			continue
+		}
+		log.Infof("Expired unpaid order %s", orderId)
+	}
+}
+
+// Returns the total paid in to the order's payment address
+func fundingReceived(records []*spvwallet.TransactionRecord) uint64 {
+	var total uint64
+	for _, r := range records {
+		if r.Value > 0 {
+			total += uint64(r.Value)
+		}
+	}
+	return total
+}
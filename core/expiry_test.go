@@ -0,0 +1,114 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestFundingReceived(t *testing.T) {
+	if fundingReceived(nil) != 0 {
+		t.Error("Orders without transactions should have received nothing")
+	}
+	records := []*spvwallet.TransactionRecord{
+		{Txid: "a", Value: 5000},
+		{Txid: "b", Value: 2500},
+		{Txid: "c", Value: -7500},
+	}
+	if total := fundingReceived(records); total != 7500 {
+		t.Errorf("Expected 7500 received, got %d", total)
+	}
+}
+
+func unpaidContract(placed time.Time) *pb.RicardianContract {
+	ts, _ := ptypes.TimestampProto(placed)
+	return &pb.RicardianContract{
+		VendorListings: []*pb.Listing{{
+			VendorID: &pb.ID{PeerID: "vendor"},
+			Item: &pb.Listing_Item{
+				Title:  "Test listing",
+				Images: []*pb.Listing_Item_Image{{Tiny: "image"}},
+			},
+		}},
+		BuyerOrder: &pb.Order{
+			BuyerID:   &pb.ID{PeerID: "buyer"},
+			Timestamp: ts,
+			Payment:   &pb.Order_Payment{Method: pb.Order_Payment_DIRECT, Amount: 10000, Address: "address"},
+		},
+	}
+}
+
+func TestExpireOrders(t *testing.T) {
+	dir, err := ioutil.TempDir("", "expiry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm)
+	ds, err := db.Create(dir, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ds.Close()
+	if err := ds.Config().Init("mnemonic", []byte("key"), ""); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	old := unpaidContract(now.Add(-time.Hour * 24 * 40))
+	recent := unpaidContract(now.Add(-time.Hour))
+	ds.Sales().Put("oldSale", *old, pb.OrderState_AWAITING_PAYMENT, false)
+	ds.Sales().Put("recentSale", *recent, pb.OrderState_AWAITING_PAYMENT, false)
+	ds.Sales().Put("partialSale", *old, pb.OrderState_AWAITING_PAYMENT, false)
+	ds.Sales().UpdateFunding("partialSale", false, []*spvwallet.TransactionRecord{{Txid: "a", Value: 4000}})
+	ds.Purchases().Put("oldPurchase", *old, pb.OrderState_AWAITING_PAYMENT, false)
+	ds.Purchases().Put("recentPurchase", *recent, pb.OrderState_AWAITING_PAYMENT, false)
+
+	broadcast := make(chan interface{}, 10)
+	e := NewOrderExpirer(ds, broadcast, time.Hour*24*30)
+	e.ExpireOrders(now)
+	e.ExpireOrders(now)
+
+	for orderId, expected := range map[string]pb.OrderState{
+		"oldSale":     pb.OrderState_EXPIRED,
+		"recentSale":  pb.OrderState_AWAITING_PAYMENT,
+		"partialSale": pb.OrderState_AWAITING_PAYMENT,
+	} {
+		_, state, _, _, _, err := ds.Sales().GetByOrderId(orderId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != expected {
+			t.Errorf("%s: expected %s, got %s", orderId, expected, state)
+		}
+	}
+	for orderId, expected := range map[string]pb.OrderState{
+		"oldPurchase":    pb.OrderState_EXPIRED,
+		"recentPurchase": pb.OrderState_AWAITING_PAYMENT,
+	} {
+		_, state, _, _, _, err := ds.Purchases().GetByOrderId(orderId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != expected {
+			t.Errorf("%s: expected %s, got %s", orderId, expected, state)
+		}
+	}
+
+	// The partially paid sale is flagged once, not on every run
+	if len(broadcast) != 1 {
+		t.Fatalf("Expected one notification, got %d", len(broadcast))
+	}
+	n, ok := (<-broadcast).(notifications.PartialPaymentNotification)
+	if !ok || n.OrderId != "partialSale" || n.FundingTotal != 4000 || n.RequestedAmount != 10000 {
+		t.Errorf("Unexpected notification %v", n)
+	}
+}
@@ -24,7 +24,9 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 	rc := new(pb.RicardianContract)
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		payout := new(pb.OrderFulfillment_Payout)
-		payout.PayoutAddress = n.Wallet.CurrentAddress(spvwallet.EXTERNAL).EncodeAddress()
+		payoutAddress, derivation := n.PayoutAddress()
+		payout.PayoutAddress = payoutAddress.EncodeAddress()
+		payout.PayoutAddressDerivation = derivation
 		payout.PayoutFeePerByte = n.Wallet.GetFeePerByte(spvwallet.NORMAL)
 		var ins []spvwallet.TransactionInput
 		var outValue int64
@@ -150,7 +152,7 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 	} else {
 		n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_PARTIALLY_FULFILLED, false)
 	}
-	return nil
+	return n.recordResponseTime(contract, contract.VendorOrderConfirmation.OrderID)
 }
 
 func (n *OpenBazaarNode) SignOrderFulfillment(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
@@ -40,6 +40,7 @@ const (
 	PolicyMaxCharacters      = 10000
 	AboutMaxCharacters       = 10000
 	URLMaxCharacters         = 2000
+	AddressMaxCharacters     = 1000
 	MaxCountryCodes          = 255
 )
 
@@ -67,6 +68,8 @@ type listingData struct {
 	Language      string    `json:"language"`
 	AverageRating float32   `json:"averageRating"`
 	RatingCount   uint32    `json:"ratingCount"`
+	Quantity      *int64    `json:"quantity,omitempty"`
+	InStock       *bool     `json:"inStock,omitempty"`
 }
 
 func (n *OpenBazaarNode) GenerateSlug(title string) (string, error) {
@@ -288,9 +291,74 @@ func (n *OpenBazaarNode) extractListingData(listing *pb.SignedListing) (listingD
 		FreeShipping: freeShipping,
 		Language:     listing.Listing.Metadata.Language,
 	}
+
+	inventory, err := n.Datastore.Inventory().Get(listing.Listing.Slug)
+	if err != nil {
+		return listingData{}, err
+	}
+	ld.Quantity, ld.InStock = publicInventory(listing.Listing.Item.QuantityDisplay, inventory)
 	return ld, nil
 }
 
+/* Returns the view of the listing's inventory that may be shown publicly. Exact shows the
+   total quantity across all skus, Boolean only whether anything is in stock, and Hidden
+   shows nothing. A sku quantity of -1 means unlimited inventory. This is display only,
+   orders are always checked against the exact inventory. */
+func publicInventory(mode pb.Listing_Item_QuantityDisplay, inventory map[int]int) (quantity *int64, inStock *bool) {
+	var total int64
+	for _, count := range inventory {
+		if count < 0 {
+			total = -1
+			break
+		}
+		total += int64(count)
+	}
+	available := total != 0
+
+	switch mode {
+	case pb.Listing_Item_EXACT:
+		return &total, &available
+	case pb.Listing_Item_BOOLEAN:
+		return nil, &available
+	default:
+		return nil, nil
+	}
+}
+
+/* Recomputes the public inventory of a listing in the index. The index is otherwise only
+   rebuilt when the listing itself is updated, so this must be called whenever the inventory
+   changes on its own, such as after a sale or an inventory update through the API. */
+func (n *OpenBazaarNode) UpdateIndexInventory(slug string) error {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return err
+	}
+	listing, err := n.GetListingFromSlug(slug)
+	if err != nil {
+		return err
+	}
+	inventory, err := n.Datastore.Inventory().Get(slug)
+	if err != nil {
+		return err
+	}
+	ld, ok := refreshIndexInventory(index, slug, listing.Listing.Item.QuantityDisplay, inventory)
+	if !ok {
+		return errors.New("Listing does not exist in index")
+	}
+	return n.updateListingOnDisk(index, ld, true)
+}
+
+// Returns the index entry for the slug with its public inventory recomputed
+func refreshIndexInventory(index []listingData, slug string, mode pb.Listing_Item_QuantityDisplay, inventory map[int]int) (listingData, bool) {
+	for _, ld := range index {
+		if ld.Slug == slug {
+			ld.Quantity, ld.InStock = publicInventory(mode, inventory)
+			return ld, true
+		}
+	}
+	return listingData{}, false
+}
+
 func (n *OpenBazaarNode) getListingIndex() ([]listingData, error) {
 	indexPath := path.Join(n.RepoPath, "root", "listings", "index.json")
 
@@ -1009,6 +1077,19 @@ func validateListing(listing *pb.Listing) (err error) {
 		return fmt.Errorf("Refun policy length must be less than the max of %d", PolicyMaxCharacters)
 	}
 
+	// ReturnInstructions
+	if listing.ReturnInstructions != nil {
+		if len(listing.ReturnInstructions.Address) > AddressMaxCharacters {
+			return fmt.Errorf("Return address length must be less than the max of %d", AddressMaxCharacters)
+		}
+		if listing.ReturnInstructions.RestockingFee < 0 || listing.ReturnInstructions.RestockingFee > 100 {
+			return errors.New("Restocking fee must be a percentage between 0 and 100")
+		}
+		if len(listing.ReturnInstructions.Conditions) > PolicyMaxCharacters {
+			return fmt.Errorf("Return conditions length must be less than the max of %d", PolicyMaxCharacters)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestPublicInventoryExact(t *testing.T) {
+	quantity, inStock := publicInventory(pb.Listing_Item_EXACT, map[int]int{0: 3, 1: 4})
+	if quantity == nil || *quantity != 7 {
+		t.Error("Exact mode should show the total quantity")
+	}
+	if inStock == nil || !*inStock {
+		t.Error("Exact mode should show the item in stock")
+	}
+
+	quantity, inStock = publicInventory(pb.Listing_Item_EXACT, map[int]int{0: 0})
+	if quantity == nil || *quantity != 0 || inStock == nil || *inStock {
+		t.Error("Exact mode should show an empty inventory as out of stock")
+	}
+
+	quantity, inStock = publicInventory(pb.Listing_Item_EXACT, map[int]int{0: 5, 1: -1})
+	if quantity == nil || *quantity != -1 || inStock == nil || !*inStock {
+		t.Error("Exact mode should show unlimited inventory as -1")
+	}
+}
+
+func TestPublicInventoryBoolean(t *testing.T) {
+	quantity, inStock := publicInventory(pb.Listing_Item_BOOLEAN, map[int]int{0: 3})
+	if quantity != nil {
+		t.Error("Boolean mode should not show the quantity")
+	}
+	if inStock == nil || !*inStock {
+		t.Error("Boolean mode should show the item in stock")
+	}
+
+	_, inStock = publicInventory(pb.Listing_Item_BOOLEAN, map[int]int{0: 0, 1: 0})
+	if inStock == nil || *inStock {
+		t.Error("Boolean mode should show the item out of stock")
+	}
+}
+
+func TestPublicInventoryHidden(t *testing.T) {
+	quantity, inStock := publicInventory(pb.Listing_Item_HIDDEN, map[int]int{0: 3})
+	if quantity != nil || inStock != nil {
+		t.Error("Hidden mode should not show any inventory")
+	}
+}
+
+func TestRefreshIndexInventory(t *testing.T) {
+	ld := listingData{Slug: "shirt", AverageRating: 4.5, RatingCount: 2}
+	ld.Quantity, ld.InStock = publicInventory(pb.Listing_Item_EXACT, map[int]int{0: 3})
+	index := []listingData{{Slug: "hat"}, ld}
+
+	// Selling the last three shirts should take the listing out of stock
+	refreshed, ok := refreshIndexInventory(index, "shirt", pb.Listing_Item_EXACT, map[int]int{0: 0})
+	if !ok {
+		t.Fatal("Listing should be found in the index")
+	}
+	if refreshed.Quantity == nil || *refreshed.Quantity != 0 || refreshed.InStock == nil || *refreshed.InStock {
+		t.Error("Public inventory should follow the new inventory")
+	}
+	if refreshed.AverageRating != 4.5 || refreshed.RatingCount != 2 {
+		t.Error("Refreshing the inventory should keep the ratings")
+	}
+
+	refreshed, _ = refreshIndexInventory(index, "shirt", pb.Listing_Item_EXACT, map[int]int{0: 8})
+	if refreshed.Quantity == nil || *refreshed.Quantity != 8 || !*refreshed.InStock {
+		t.Error("Public inventory should follow restocked inventory")
+	}
+
+	if _, ok := refreshIndexInventory(index, "socks", pb.Listing_Item_EXACT, map[int]int{0: 1}); ok {
+		t.Error("Unknown slug should not be found in the index")
+	}
+}
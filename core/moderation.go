@@ -263,9 +263,11 @@ func (n *OpenBazaarNode) NotifyModerators(moderators []string) error {
 	removedMods := currentMods
 
 	for _, mod := range addedMods {
+		n.ProtectPeer(mod, ModeratorProtectTag)
 		go n.SendModeratorAdd(mod)
 	}
 	for mod := range removedMods {
+		n.UnprotectPeer(mod, ModeratorProtectTag)
 		go n.SendModeratorRemove(mod)
 	}
 	return nil
@@ -183,6 +183,22 @@ func (n *OpenBazaarNode) SendOrderConfirmation(peerId string, contract *pb.Ricar
 	return n.sendMessage(peerId, &k, m)
 }
 
+func (n *OpenBazaarNode) SendOrderAdjustment(peerId string, contract *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(contract)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_ORDER_ADJUSTMENT,
+		Payload:     a,
+	}
+	k, err := libp2p.UnmarshalPublicKey(contract.GetBuyerOrder().GetBuyerID().GetPubkeys().Identity)
+	if err != nil {
+		return err
+	}
+	return n.sendMessage(peerId, &k, m)
+}
+
 func (n *OpenBazaarNode) SendCancel(peerId, orderId string) error {
 	a := &any.Any{Value: []byte(orderId)}
 	m := pb.Message{
@@ -308,6 +324,18 @@ func (n *OpenBazaarNode) SendDisputeClose(peerId string, k *libp2p.PubKey, resol
 	return nil
 }
 
+func (n *OpenBazaarNode) SendDisputeEndorsement(peerId string, k *libp2p.PubKey, endorsementMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(endorsementMessage)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_DISPUTE_ENDORSEMENT,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, k, m)
+}
+
 func (n *OpenBazaarNode) SendChat(peerId string, chatMessage *pb.Chat) error {
 	a, err := ptypes.MarshalAny(chatMessage)
 	if err != nil {
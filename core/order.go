@@ -12,11 +12,13 @@ import (
 	"time"
 
 	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/spvwallet"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	hd "github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -43,17 +45,20 @@ type item struct {
 }
 
 type PurchaseData struct {
-	ShipTo               string  `json:"shipTo"`
-	Address              string  `json:"address"`
-	City                 string  `json:"city"`
-	State                string  `json:"state"`
-	PostalCode           string  `json:"postalCode"`
-	CountryCode          string  `json:"countryCode"`
-	AddressNotes         string  `json:"addressNotes"`
-	Moderator            string  `json:"moderator"`
-	Items                []item  `json:"items"`
-	AlternateContactInfo string  `json:"alternateContactInfo"`
-	RefundAddress        *string `json:"refundAddress"` //optional, can be left out of json
+	ShipTo               string   `json:"shipTo"`
+	Address              string   `json:"address"`
+	City                 string   `json:"city"`
+	State                string   `json:"state"`
+	PostalCode           string   `json:"postalCode"`
+	CountryCode          string   `json:"countryCode"`
+	AddressNotes         string   `json:"addressNotes"`
+	Moderator            string   `json:"moderator"`
+	Moderators           []string `json:"moderators"`         // optional panel, lead moderator first
+	ModeratorThreshold   int      `json:"moderatorThreshold"` // panel moderators needed to resolve a dispute
+	Items                []item   `json:"items"`
+	AlternateContactInfo string   `json:"alternateContactInfo"`
+	RefundAddress        *string  `json:"refundAddress"`   //optional, can be left out of json
+	BatchSettlement      bool     `json:"batchSettlement"` // pay later together with other orders to the same vendor
 }
 
 func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAddress string, paymentAmount uint64, vendorOnline bool, err error) {
@@ -62,33 +67,58 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		return "", "", 0, false, err
 	}
 
+	// Batched orders wait for the Settler to pay them
+	initialState := pb.OrderState_AWAITING_PAYMENT
+	if data.BatchSettlement {
+		if _, ok := n.Wallet.(bitcoin.BatchWallet); !ok {
+			return "", "", 0, false, ErrBatchingUnsupported
+		}
+		initialState = pb.OrderState_PENDING_SETTLEMENT
+	}
+
 	// Add payment data and send to vendor
-	if data.Moderator != "" { // Moderated payment
-		if data.Moderator == n.IpfsNode.Identity.Pretty() {
-			return "", "", 0, false, errors.New("Cannot select self as moderator")
+	if data.Moderator != "" || len(data.Moderators) > 0 { // Moderated payment
+		panel := data.Moderators
+		if len(panel) == 0 {
+			panel = []string{data.Moderator}
 		}
-		if data.Moderator == contract.VendorListings[0].VendorID.PeerID {
-			return "", "", 0, false, errors.New("Cannot select vendor as moderator")
+		if data.Moderator != "" && data.Moderator != panel[0] {
+			return "", "", 0, false, errors.New("The lead moderator must be first in the moderator panel")
 		}
-		payment := new(pb.Order_Payment)
-		payment.Method = pb.Order_Payment_MODERATED
-		payment.Moderator = data.Moderator
-		ipnsPath := ipfspath.FromString(data.Moderator + "/profile")
-		profileBytes, err := ipfs.ResolveThenCat(n.Context, ipnsPath)
-		if err != nil {
-			return "", "", 0, false, errors.New("Moderator could not be found")
+		if len(panel) > MaxPanelSize {
+			return "", "", 0, false, fmt.Errorf("A moderator panel may not have more than %d moderators", MaxPanelSize)
 		}
-		profile := new(pb.Profile)
-		err = jsonpb.UnmarshalString(string(profileBytes), profile)
-		if err != nil {
-			return "", "", 0, false, err
-		}
-		moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
-		if err != nil {
-			return "", "", 0, false, err
+		if len(panel) > 1 && (data.ModeratorThreshold < 1 || data.ModeratorThreshold > len(panel)) {
+			return "", "", 0, false, errors.New("The panel threshold must be between one and the number of moderators")
 		}
-		if !profile.Moderator || profile.ModeratorInfo == nil || strings.ToLower(profile.ModeratorInfo.AcceptedCurrency) != strings.ToLower(n.Wallet.CurrencyCode()) {
-			return "", "", 0, false, errors.New("Moderator is not capable of moderating this transaction")
+		payment := new(pb.Order_Payment)
+		payment.Method = pb.Order_Payment_MODERATED
+		payment.Moderator = panel[0]
+		var moderatorKeysBytes [][]byte
+		for i, mod := range panel {
+			if mod == n.IpfsNode.Identity.Pretty() {
+				return "", "", 0, false, errors.New("Cannot select self as moderator")
+			}
+			if mod == contract.VendorListings[0].VendorID.PeerID {
+				return "", "", 0, false, errors.New("Cannot select vendor as moderator")
+			}
+			for _, prev := range panel[:i] {
+				if prev == mod {
+					return "", "", 0, false, errors.New("Duplicate moderator in panel")
+				}
+			}
+			profile, err := n.fetchModeratorProfile(mod)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+			moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+			if !profile.Moderator || profile.ModeratorInfo == nil || strings.ToLower(profile.ModeratorInfo.AcceptedCurrency) != strings.ToLower(n.Wallet.CurrencyCode()) {
+				return "", "", 0, false, errors.New("Moderator is not capable of moderating this transaction")
+			}
+			moderatorKeysBytes = append(moderatorKeysBytes, moderatorKeyBytes)
 		}
 		total, err := n.CalculateOrderTotal(contract)
 		if err != nil {
@@ -97,7 +127,8 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		payment.Amount = total
 
 		/* Generate a payment address using the first child key derived from the buyers's,
-		   vendors's and moderator's masterPubKey and a random chaincode. */
+		   vendors's and moderator's masterPubKey and a random chaincode. A panel uses the
+		   keys of all its moderators. */
 		chaincode := make([]byte, 32)
 		_, err = rand.Read(chaincode)
 		if err != nil {
@@ -130,21 +161,24 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		hdKey = hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			moderatorKeyBytes,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-
-		moderatorKey, err := hdKey.Child(0)
-		if err != nil {
-			return "", "", 0, false, err
+		var moderatorKeys []*hd.ExtendedKey
+		for _, moderatorKeyBytes := range moderatorKeysBytes {
+			moderatorKey, err := n.escrowPublicKey(moderatorKeyBytes, chaincode)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+			moderatorKeys = append(moderatorKeys, moderatorKey)
 		}
 
-		addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2)
+		var addr btcutil.Address
+		var redeemScript []byte
+		if len(panel) > 1 {
+			addr, redeemScript, err = n.GeneratePanelScript(buyerKey, vendorKey, moderatorKeys, data.ModeratorThreshold)
+			payment.ModeratorPanel = panel
+			payment.ModeratorThreshold = uint32(data.ModeratorThreshold)
+		} else {
+			addr, redeemScript, err = n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKeys[0]}, 2)
+		}
 		if err != nil {
 			return "", "", 0, false, err
 		}
@@ -194,7 +228,8 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+			n.Datastore.Purchases().Put(orderId, *contract, initialState, false)
+			n.ProtectPeer(contract.VendorListings[0].VendorID.PeerID, OrderProtectTag(orderId))
 			return orderId, contract.BuyerOrder.Payment.Address, contract.BuyerOrder.Payment.Amount, false, err
 		} else { // Vendor responded
 			if resp.MessageType == pb.Message_ERROR {
@@ -225,7 +260,8 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+			n.Datastore.Purchases().Put(orderId, *contract, initialState, false)
+			n.ProtectPeer(contract.VendorListings[0].VendorID.PeerID, OrderProtectTag(orderId))
 			return orderId, contract.VendorOrderConfirmation.PaymentAddress, contract.BuyerOrder.Payment.Amount, true, nil
 		}
 	} else { // Direct payment
@@ -329,7 +365,8 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+			n.Datastore.Purchases().Put(orderId, *contract, initialState, false)
+			n.ProtectPeer(contract.VendorListings[0].VendorID.PeerID, OrderProtectTag(orderId))
 			return orderId, contract.BuyerOrder.Payment.Address, contract.BuyerOrder.Payment.Amount, false, err
 		} else { // Vendor responded
 			if resp.MessageType == pb.Message_ERROR {
@@ -366,7 +403,8 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 			if err != nil {
 				return "", "", 0, false, err
 			}
-			n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+			n.Datastore.Purchases().Put(orderId, *contract, initialState, false)
+			n.ProtectPeer(contract.VendorListings[0].VendorID.PeerID, OrderProtectTag(orderId))
 			return orderId, contract.VendorOrderConfirmation.PaymentAddress, contract.BuyerOrder.Payment.Amount, true, nil
 		}
 	}
@@ -623,6 +661,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 		return err
 	}
 	n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_CANCELED, true)
+	n.UnprotectPeer(contract.VendorListings[0].VendorID.PeerID, OrderProtectTag(orderId))
 	return nil
 }
 
@@ -976,6 +1015,11 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract) error {
 		if !validMod {
 			return errors.New("Invalid moderator")
 		}
+		if IsPanel(contract.BuyerOrder.Payment) {
+			if err := validatePanel(contract); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Validate that the hash of the items in the contract match claimed hash in the order
@@ -1215,6 +1259,9 @@ func (n *OpenBazaarNode) ValidateDirectPaymentAddress(order *pb.Order) error {
 }
 
 func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order) error {
+	if IsPanel(order.Payment) {
+		return n.validatePanelPaymentAddress(order)
+	}
 	ipnsPath := ipfspath.FromString(order.Payment.Moderator + "/profile")
 	profileBytes, err := ipfs.ResolveThenCat(n.Context, ipnsPath)
 	if err != nil {
@@ -0,0 +1,716 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	mh "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipfspath "github.com/ipfs/go-ipfs/path"
+	"github.com/ipfs/go-ipfs/routing/dht"
+	"golang.org/x/net/context"
+)
+
+// The largest number of moderators that can sit on a moderator panel
+const MaxPanelSize = 5
+
+var ErrNotLeadModerator = errors.New("Only the lead moderator can propose a resolution for this dispute")
+
+// Returns whether the order is moderated by a panel rather than by a single moderator
+func IsPanel(payment *pb.Order_Payment) bool {
+	return payment != nil && len(payment.ModeratorPanel) > 1
+}
+
+// Returns the peer IDs of everyone moderating the order, starting with the lead moderator
+func OrderModerators(payment *pb.Order_Payment) []string {
+	if payment == nil || payment.Moderator == "" {
+		return nil
+	}
+	if IsPanel(payment) {
+		return payment.ModeratorPanel
+	}
+	return []string{payment.Moderator}
+}
+
+func isOrderModerator(payment *pb.Order_Payment, peerID string) bool {
+	for _, mod := range OrderModerators(payment) {
+		if mod == peerID {
+			return true
+		}
+	}
+	return false
+}
+
+/* Build the escrow script for an order moderated by a panel. The buyer and vendor can
+   spend together just like with a single moderator, or either one of them can spend
+   together with threshold of the panel moderators:
+
+   OP_IF
+       2 <buyer> <vendor> 2 OP_CHECKMULTISIG
+   OP_ELSE
+       <threshold> <moderator 1> ... <moderator n> <n> OP_CHECKMULTISIGVERIFY
+       1 <buyer> <vendor> 2 OP_CHECKMULTISIG
+   OP_ENDIF
+
+   All keys are compressed public keys. */
+func PanelScript(buyerKey, vendorKey []byte, moderatorKeys [][]byte, threshold int) ([]byte, error) {
+	if len(moderatorKeys) < 2 || len(moderatorKeys) > MaxPanelSize {
+		return nil, fmt.Errorf("A moderator panel must have between 2 and %d moderators", MaxPanelSize)
+	}
+	if threshold < 1 || threshold > len(moderatorKeys) {
+		return nil, errors.New("The panel threshold must be between one and the number of moderators")
+	}
+	for _, key := range append([][]byte{buyerKey, vendorKey}, moderatorKeys...) {
+		if len(key) != 33 {
+			return nil, errors.New("Escrow keys must be compressed public keys")
+		}
+	}
+	builder := txscript.NewScriptBuilder()
+	builder.AddOp(txscript.OP_IF)
+	builder.AddInt64(2)
+	builder.AddData(buyerKey)
+	builder.AddData(vendorKey)
+	builder.AddInt64(2)
+	builder.AddOp(txscript.OP_CHECKMULTISIG)
+	builder.AddOp(txscript.OP_ELSE)
+	builder.AddInt64(int64(threshold))
+	for _, key := range moderatorKeys {
+		builder.AddData(key)
+	}
+	builder.AddInt64(int64(len(moderatorKeys)))
+	builder.AddOp(txscript.OP_CHECKMULTISIGVERIFY)
+	builder.AddInt64(1)
+	builder.AddData(buyerKey)
+	builder.AddData(vendorKey)
+	builder.AddInt64(2)
+	builder.AddOp(txscript.OP_CHECKMULTISIG)
+	builder.AddOp(txscript.OP_ENDIF)
+	return builder.Script()
+}
+
+// Returns the keys and threshold from a script built by PanelScript
+func ParsePanelScript(script []byte) (buyerKey, vendorKey []byte, moderatorKeys [][]byte, threshold int, err error) {
+	invalid := errors.New("Not a moderator panel script")
+	const keyPush = 1 + 33
+
+	// OP_IF 2 <buyer> <vendor> 2 OP_CHECKMULTISIG OP_ELSE <threshold>
+	head := 2 + 2*keyPush + 3 + 1
+	if len(script) < head || script[0] != txscript.OP_IF {
+		return nil, nil, nil, 0, invalid
+	}
+	buyerKey = script[3 : 2+keyPush]
+	vendorKey = script[3+keyPush : 2+2*keyPush]
+	threshold = int(script[head-1]) - txscript.OP_1 + 1
+	for i := head; i+keyPush <= len(script) && script[i] == txscript.OP_DATA_33; i += keyPush {
+		moderatorKeys = append(moderatorKeys, script[i+1:i+keyPush])
+	}
+
+	// Anything unexpected in between will fail to match the rebuilt script
+	expected, err := PanelScript(buyerKey, vendorKey, moderatorKeys, threshold)
+	if err != nil || !bytes.Equal(expected, script) {
+		return nil, nil, nil, 0, invalid
+	}
+	return buyerKey, vendorKey, moderatorKeys, threshold, nil
+}
+
+// Returns the hex encoded key of the lead moderator if the redeem script is a panel script
+func panelLeadKey(redeemScript string) (string, bool) {
+	script, err := hex.DecodeString(redeemScript)
+	if err != nil {
+		return "", false
+	}
+	_, _, moderatorKeys, _, err := ParsePanelScript(script)
+	if err != nil {
+		return "", false
+	}
+	return hex.EncodeToString(moderatorKeys[0]), true
+}
+
+// Returns the escrow address and redeem script for a panel moderated order
+func (n *OpenBazaarNode) GeneratePanelScript(buyerKey, vendorKey *hd.ExtendedKey, moderatorKeys []*hd.ExtendedKey, threshold int) (btcutil.Address, []byte, error) {
+	var keys [][]byte
+	for _, key := range append([]*hd.ExtendedKey{buyerKey, vendorKey}, moderatorKeys...) {
+		ecKey, err := key.ECPubKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, ecKey.SerializeCompressed())
+	}
+	redeemScript, err := PanelScript(keys[0], keys[1], keys[2:], threshold)
+	if err != nil {
+		return nil, nil, err
+	}
+	addr, err := btcutil.NewAddressScriptHash(redeemScript, n.Wallet.Params())
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, redeemScript, nil
+}
+
+// Derive the key a party uses in the escrow from their master public key and the order's chaincode
+func (n *OpenBazaarNode) escrowPublicKey(masterPubKey, chaincode []byte) (*hd.ExtendedKey, error) {
+	hdKey := hd.NewExtendedKey(
+		n.Wallet.Params().HDPublicKeyID[:],
+		masterPubKey,
+		chaincode,
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		0,
+		0,
+		false)
+	return hdKey.Child(0)
+}
+
+// Derive our own escrow signing key for the order with the given chaincode
+func (n *OpenBazaarNode) escrowPrivateKey(chaincode string) (*hd.ExtendedKey, error) {
+	chaincodeBytes, err := hex.DecodeString(chaincode)
+	if err != nil {
+		return nil, err
+	}
+	mECKey, err := n.Wallet.MasterPrivateKey().ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	hdKey := hd.NewExtendedKey(
+		n.Wallet.Params().HDPrivateKeyID[:],
+		mECKey.Serialize(),
+		chaincodeBytes,
+		[]byte{0x00, 0x00, 0x00, 0x00},
+		0,
+		0,
+		true)
+	return hdKey.Child(0)
+}
+
+func (n *OpenBazaarNode) fetchModeratorProfile(peerID string) (*pb.Profile, error) {
+	ipnsPath := ipfspath.FromString(peerID + "/profile")
+	profileBytes, err := ipfs.ResolveThenCat(n.Context, ipnsPath)
+	if err != nil {
+		return nil, errors.New("Moderator could not be found")
+	}
+	profile := new(pb.Profile)
+	err = jsonpb.UnmarshalString(string(profileBytes), profile)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// Validate the moderator panel in an order against the moderators the vendor offers
+func validatePanel(contract *pb.RicardianContract) error {
+	payment := contract.BuyerOrder.Payment
+	if len(payment.ModeratorPanel) > MaxPanelSize {
+		return fmt.Errorf("A moderator panel may not have more than %d moderators", MaxPanelSize)
+	}
+	if payment.ModeratorPanel[0] != payment.Moderator {
+		return errors.New("The lead moderator must be first in the moderator panel")
+	}
+	if payment.ModeratorThreshold < 1 || int(payment.ModeratorThreshold) > len(payment.ModeratorPanel) {
+		return errors.New("Invalid moderator panel threshold")
+	}
+	var availableMods []string
+	for _, listing := range contract.VendorListings {
+		availableMods = append(availableMods, listing.Moderators...)
+	}
+	for i, mod := range payment.ModeratorPanel {
+		_, err := mh.FromB58String(mod)
+		if err != nil {
+			return errors.New("Invalid moderator")
+		}
+		if mod == contract.BuyerOrder.BuyerID.PeerID || mod == contract.VendorListings[0].VendorID.PeerID {
+			return errors.New("The buyer and vendor cannot sit on the moderator panel")
+		}
+		for _, prev := range payment.ModeratorPanel[:i] {
+			if prev == mod {
+				return errors.New("Duplicate moderator in panel")
+			}
+		}
+		validMod := false
+		for _, available := range availableMods {
+			if available == mod {
+				validMod = true
+				break
+			}
+		}
+		if !validMod {
+			return errors.New("Invalid moderator")
+		}
+	}
+	return nil
+}
+
+// Check the escrow address of a panel moderated order we received as the vendor
+func (n *OpenBazaarNode) validatePanelPaymentAddress(order *pb.Order) error {
+	chaincode, err := hex.DecodeString(order.Payment.Chaincode)
+	if err != nil {
+		return err
+	}
+	mECKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+	if err != nil {
+		return err
+	}
+	vendorKey, err := n.escrowPublicKey(mECKey.SerializeCompressed(), chaincode)
+	if err != nil {
+		return err
+	}
+	buyerKey, err := n.escrowPublicKey(order.BuyerID.Pubkeys.Bitcoin, chaincode)
+	if err != nil {
+		return err
+	}
+	var moderatorKeys []*hd.ExtendedKey
+	for _, mod := range order.Payment.ModeratorPanel {
+		profile, err := n.fetchModeratorProfile(mod)
+		if err != nil {
+			return err
+		}
+		moderatorBytes, err := hex.DecodeString(profile.BitcoinPubkey)
+		if err != nil {
+			return err
+		}
+		moderatorKey, err := n.escrowPublicKey(moderatorBytes, chaincode)
+		if err != nil {
+			return err
+		}
+		moderatorKeys = append(moderatorKeys, moderatorKey)
+	}
+	addr, redeemScript, err := n.GeneratePanelScript(buyerKey, vendorKey, moderatorKeys, int(order.Payment.ModeratorThreshold))
+	if err != nil {
+		return err
+	}
+	if order.Payment.Address != addr.EncodeAddress() {
+		return errors.New("Invalid payment address")
+	}
+	if order.Payment.RedeemScript != hex.EncodeToString(redeemScript) {
+		return errors.New("Invalid redeem script")
+	}
+	return nil
+}
+
+/* Check the escrow of a case we moderate as part of a panel. We can't recompute the whole
+   script without fetching the other moderators' profiles, so instead we check the script is
+   well formed, that our own key sits at our place in the panel and that the buyer's and
+   vendor's keys and the threshold match the order. */
+func (n *OpenBazaarNode) validatePanelCaseScript(payment *pb.Order_Payment, buyerKey, vendorKey, moderatorKey *hd.ExtendedKey) []string {
+	var validationErrors []string
+	script, err := hex.DecodeString(payment.RedeemScript)
+	if err != nil {
+		return append(validationErrors, "Error validating bitcoin address and redeem script")
+	}
+	scriptBuyerKey, scriptVendorKey, scriptModeratorKeys, threshold, err := ParsePanelScript(script)
+	if err != nil {
+		return append(validationErrors, "The redeem script in the order is not a valid moderator panel script")
+	}
+	serialize := func(key *hd.ExtendedKey) []byte {
+		ecKey, err := key.ECPubKey()
+		if err != nil {
+			return nil
+		}
+		return ecKey.SerializeCompressed()
+	}
+	matches := bytes.Equal(scriptBuyerKey, serialize(buyerKey)) && bytes.Equal(scriptVendorKey, serialize(vendorKey))
+	if threshold != int(payment.ModeratorThreshold) || len(scriptModeratorKeys) != len(payment.ModeratorPanel) {
+		matches = false
+	}
+	for i, mod := range payment.ModeratorPanel {
+		if mod == n.IpfsNode.Identity.Pretty() && (i >= len(scriptModeratorKeys) || !bytes.Equal(scriptModeratorKeys[i], serialize(moderatorKey))) {
+			matches = false
+		}
+	}
+	if !matches {
+		validationErrors = append(validationErrors, "The calculated redeem script doesn't match the redeem script in the order")
+	}
+	addr, err := btcutil.NewAddressScriptHash(script, n.Wallet.Params())
+	if err != nil || addr.EncodeAddress() != payment.Address {
+		validationErrors = append(validationErrors, "The calculated bitcoin address doesn't match the address in the order")
+	}
+	return validationErrors
+}
+
+func signatureForInput(sigs []spvwallet.Signature, i int) []byte {
+	for _, sig := range sigs {
+		if int(sig.InputIndex) == i {
+			return sig.Signature
+		}
+	}
+	return nil
+}
+
+/* Combine the buyer's and vendor's signatures into a transaction spending from the order's
+   escrow address. Panel moderated orders spend through the cooperative branch of the panel
+   script, everyone else through the plain 2 of 3 multisig. */
+func (n *OpenBazaarNode) MultisignEscrow(payment *pb.Order_Payment, ins []spvwallet.TransactionInput, outs []spvwallet.TransactionOutput, buyerSigs []spvwallet.Signature, vendorSigs []spvwallet.Signature, redeemScript []byte, feePerByte uint64, broadcast bool) ([]byte, error) {
+	if !IsPanel(payment) {
+		return n.Wallet.Multisign(ins, outs, buyerSigs, vendorSigs, redeemScript, feePerByte, broadcast)
+	}
+	sigScripts := make([][]byte, len(ins))
+	for i := range ins {
+		builder := txscript.NewScriptBuilder()
+		builder.AddOp(txscript.OP_0)
+		builder.AddData(signatureForInput(buyerSigs, i))
+		builder.AddData(signatureForInput(vendorSigs, i))
+		builder.AddOp(txscript.OP_TRUE)
+		sigScript, err := builder.Script()
+		if err != nil {
+			return nil, err
+		}
+		sigScripts[i] = sigScript
+	}
+	return n.Wallet.MultisignScripts(ins, outs, sigScripts, redeemScript, feePerByte, broadcast)
+}
+
+// Returns whether enough of the panel agrees with the dispute resolution to release the funds
+func PanelApproved(contract *pb.RicardianContract) bool {
+	payment := contract.BuyerOrder.Payment
+	if !IsPanel(payment) {
+		return true
+	}
+	if contract.DisputeResolution == nil {
+		return false
+	}
+	// The lead moderator's resolution counts as the first signature
+	return 1+len(contract.DisputeEndorsements) >= int(payment.ModeratorThreshold)
+}
+
+/* Build the signature scripts that spend a panel escrow through the dispute branch. Each
+   script holds our own signature followed by threshold moderator signatures, which must be
+   in the same order as the moderator keys in the redeem script. */
+func panelSigScripts(contract *pb.RicardianContract, mySigs []spvwallet.Signature, inputs int) ([][]byte, error) {
+	payment := contract.BuyerOrder.Payment
+	moderatorSigs := make(map[string][]*pb.BitcoinSignature)
+	moderatorSigs[payment.Moderator] = contract.DisputeResolution.Payout.Sigs
+	for _, e := range contract.DisputeEndorsements {
+		moderatorSigs[e.ModeratorID] = e.Sigs
+	}
+	sigScripts := make([][]byte, inputs)
+	for i := 0; i < inputs; i++ {
+		builder := txscript.NewScriptBuilder()
+		builder.AddOp(txscript.OP_0)
+		builder.AddData(signatureForInput(mySigs, i))
+		builder.AddOp(txscript.OP_0)
+		count := 0
+		for _, mod := range payment.ModeratorPanel {
+			if count == int(payment.ModeratorThreshold) {
+				break
+			}
+			for _, sig := range moderatorSigs[mod] {
+				if int(sig.InputIndex) == i {
+					builder.AddData(sig.Signature)
+					count++
+					break
+				}
+			}
+		}
+		if count < int(payment.ModeratorThreshold) {
+			return nil, errors.New("Not enough moderator signatures to release the funds")
+		}
+		builder.AddOp(txscript.OP_FALSE)
+		sigScript, err := builder.Script()
+		if err != nil {
+			return nil, err
+		}
+		sigScripts[i] = sigScript
+	}
+	return sigScripts, nil
+}
+
+// Returns the inputs and outputs of the transaction paying out a dispute resolution
+func disputePayoutTx(payout *pb.DisputeResolution_Payout) ([]spvwallet.TransactionInput, []spvwallet.TransactionOutput, error) {
+	var inputs []spvwallet.TransactionInput
+	for _, o := range payout.Inputs {
+		decodedHash, err := hex.DecodeString(o.Hash)
+		if err != nil {
+			return nil, nil, err
+		}
+		input := spvwallet.TransactionInput{
+			OutpointHash:  decodedHash,
+			OutpointIndex: o.Index,
+		}
+		inputs = append(inputs, input)
+	}
+	if len(inputs) == 0 {
+		return nil, nil, errors.New("Transaction has no inputs")
+	}
+
+	var outputs []spvwallet.TransactionOutput
+	for _, out := range []*pb.DisputeResolution_Payout_Output{payout.BuyerOutput, payout.VendorOutput, payout.ModeratorOutput} {
+		if out == nil {
+			continue
+		}
+		decodedScript, err := hex.DecodeString(out.Script)
+		if err != nil {
+			return nil, nil, err
+		}
+		output := spvwallet.TransactionOutput{
+			ScriptPubKey: decodedScript,
+			Value:        int64(out.Amount),
+		}
+		outputs = append(outputs, output)
+	}
+	return inputs, outputs, nil
+}
+
+/* Called on a co-moderator when the lead moderator of the panel proposes a resolution. The
+   resolution is saved on the case so the co-moderator can review it and endorse it. */
+func (n *OpenBazaarNode) ProcessDisputeProposal(rc *pb.RicardianContract) error {
+	if rc.DisputeResolution == nil {
+		return errors.New("Dispute resolution message is nil")
+	}
+	orderId := rc.DisputeResolution.OrderId
+	buyerContract, vendorContract, _, _, _, _, state, err := n.Datastore.Cases().GetPayoutDetails(orderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	contract := buyerContract
+	if contract == nil {
+		contract = vendorContract
+	}
+	if contract == nil || contract.BuyerOrder == nil {
+		return ErrCaseNotFound
+	}
+	if state != pb.OrderState_DISPUTED {
+		return errors.New("A dispute for this order is not open")
+	}
+	payment := contract.BuyerOrder.Payment
+	if !IsPanel(payment) || !isOrderModerator(payment, n.IpfsNode.Identity.Pretty()) {
+		return errors.New("We are not a member of this order's moderator panel")
+	}
+	if rc.DisputeResolution.ProposedBy != payment.Moderator {
+		return ErrNotLeadModerator
+	}
+
+	// verifySignatureOnDisputeResolution checks against the lead moderator's key
+	proposal := &pb.RicardianContract{
+		BuyerOrder:        contract.BuyerOrder,
+		DisputeResolution: rc.DisputeResolution,
+		Signatures:        rc.Signatures,
+	}
+	err = n.verifySignatureOnDisputeResolution(proposal)
+	if err != nil {
+		return err
+	}
+	if rc.DisputeResolution.Payout == nil {
+		return errors.New("DisputeResolution contains invalid payout")
+	}
+
+	err = n.Datastore.Cases().UpdateResolution(orderId, rc.DisputeResolution)
+	if err != nil {
+		return err
+	}
+
+	notif := notifications.DisputeProposalNotification{orderId, rc.DisputeResolution.ProposedBy}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notifications.Wrap(notif), time.Now())
+	return nil
+}
+
+/* Sign the payout in the lead moderator's resolution with our own escrow key and send the
+   signatures to the buyer and vendor. Once the lead and enough co-moderators have signed,
+   either party can release the funds. */
+func (n *OpenBazaarNode) EndorseDisputeResolution(orderId string) error {
+	buyerContract, vendorContract, _, _, state, _, _, _, _, resolution, err := n.Datastore.Cases().GetCaseMetadata(orderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	contract := buyerContract
+	if contract == nil {
+		contract = vendorContract
+	}
+	if contract == nil || contract.BuyerOrder == nil {
+		return ErrCaseNotFound
+	}
+	if state != pb.OrderState_DISPUTED {
+		return errors.New("A dispute for this order is not open")
+	}
+	payment := contract.BuyerOrder.Payment
+	if !IsPanel(payment) || payment.Moderator == n.IpfsNode.Identity.Pretty() || !isOrderModerator(payment, n.IpfsNode.Identity.Pretty()) {
+		return errors.New("Only co-moderators on the order's panel can endorse a resolution")
+	}
+	if resolution == nil || resolution.Payout == nil {
+		return errors.New("The lead moderator has not proposed a resolution yet")
+	}
+
+	inputs, outputs, err := disputePayoutTx(resolution.Payout)
+	if err != nil {
+		return err
+	}
+	signingKey, err := n.escrowPrivateKey(payment.Chaincode)
+	if err != nil {
+		return err
+	}
+	redeemScriptBytes, err := hex.DecodeString(payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	sigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
+	if err != nil {
+		return err
+	}
+
+	e := new(pb.DisputeEndorsement)
+	e.OrderId = orderId
+	e.ModeratorID = n.IpfsNode.Identity.Pretty()
+	for _, sig := range sigs {
+		s := new(pb.BitcoinSignature)
+		s.InputIndex = sig.InputIndex
+		s.Signature = sig.Signature
+		e.Sigs = append(e.Sigs, s)
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	e.Timestamp = ts
+
+	rc := new(pb.RicardianContract)
+	rc.DisputeEndorsements = []*pb.DisputeEndorsement{e}
+	rc, err = n.SignDisputeEndorsement(rc)
+	if err != nil {
+		return err
+	}
+
+	buyerKey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	err = n.SendDisputeEndorsement(contract.BuyerOrder.BuyerID.PeerID, &buyerKey, rc)
+	if err != nil {
+		return err
+	}
+	vendorKey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	err = n.SendDisputeEndorsement(contract.VendorListings[0].VendorID.PeerID, &vendorKey, rc)
+	if err != nil {
+		return err
+	}
+
+	return n.Datastore.Cases().MarkAsClosed(orderId, resolution)
+}
+
+func (n *OpenBazaarNode) SignDisputeEndorsement(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedEndorsement, err := proto.Marshal(contract.DisputeEndorsements[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_DISPUTE_ENDORSEMENT
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedEndorsement)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+/* Called on the buyer and vendor when a co-moderator endorses the lead moderator's
+   resolution. The order moves to DECIDED once the panel threshold is met. */
+func (n *OpenBazaarNode) ProcessDisputeEndorsement(rc *pb.RicardianContract) error {
+	if len(rc.DisputeEndorsements) != 1 {
+		return errors.New("Dispute endorsement message is malformatted")
+	}
+	e := rc.DisputeEndorsements[0]
+
+	isPurchase := false
+	contract, state, _, _, _, err := n.Datastore.Sales().GetByOrderId(e.OrderId)
+	if err != nil {
+		contract, state, _, _, _, err = n.Datastore.Purchases().GetByOrderId(e.OrderId)
+		if err != nil {
+			return err
+		}
+		isPurchase = true
+	}
+	payment := contract.BuyerOrder.Payment
+	if !IsPanel(payment) || e.ModeratorID == payment.Moderator || !isOrderModerator(payment, e.ModeratorID) {
+		return errors.New("Dispute endorsement is not from a co-moderator on the order's panel")
+	}
+	err = n.verifySignatureOnDisputeEndorsement(rc)
+	if err != nil {
+		return err
+	}
+	for _, existing := range contract.DisputeEndorsements {
+		if existing.ModeratorID == e.ModeratorID {
+			return nil
+		}
+	}
+
+	contract.DisputeEndorsements = append(contract.DisputeEndorsements, e)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_DISPUTE_ENDORSEMENT {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	decided := state == pb.OrderState_DISPUTED && PanelApproved(contract)
+	if decided {
+		state = pb.OrderState_DECIDED
+	}
+	if isPurchase {
+		err = n.Datastore.Purchases().Put(e.OrderId, *contract, state, false)
+	} else {
+		err = n.Datastore.Sales().Put(e.OrderId, *contract, state, false)
+	}
+	if err != nil {
+		return err
+	}
+
+	if decided {
+		notif := notifications.DisputeCloseNotification{e.OrderId}
+		n.Broadcast <- notif
+		n.Datastore.Notifications().Put(notifications.Wrap(notif), time.Now())
+	}
+	return nil
+}
+
+func (n *OpenBazaarNode) verifySignatureOnDisputeEndorsement(rc *pb.RicardianContract) error {
+	e := rc.DisputeEndorsements[0]
+	moderatorID, err := peer.IDB58Decode(e.ModeratorID)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pubkey, err := n.IpfsNode.Routing.(*dht.IpfsDHT).GetPublicKey(ctx, moderatorID)
+	if err != nil {
+		log.Errorf("Failed to find public key for %s", moderatorID.Pretty())
+		return err
+	}
+	pubKeyBytes, err := pubkey.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := verifyMessageSignature(
+		e,
+		pubKeyBytes,
+		rc.Signatures,
+		pb.Signature_DISPUTE_ENDORSEMENT,
+		moderatorID.Pretty(),
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("Contract does not contain a signature for the dispute endorsement")
+		case invalidSigError:
+			return errors.New("Guid signature on dispute endorsement failed to verify")
+		case matchKeyError:
+			return errors.New("Public key in dispute endorsement does not match reported ID")
+		default:
+			return err
+		}
+	}
+	return nil
+}
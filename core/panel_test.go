@@ -0,0 +1,194 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+func newPanelKeys(t *testing.T, n int) []*btcec.PrivateKey {
+	var keys []*btcec.PrivateKey
+	for i := 0; i < n; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func serializedPubKeys(keys []*btcec.PrivateKey) [][]byte {
+	var out [][]byte
+	for _, key := range keys {
+		out = append(out, key.PubKey().SerializeCompressed())
+	}
+	return out
+}
+
+func TestPanelScriptRoundTrip(t *testing.T) {
+	keys := serializedPubKeys(newPanelKeys(t, 5))
+	script, err := PanelScript(keys[0], keys[1], keys[2:], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buyerKey, vendorKey, moderatorKeys, threshold, err := ParsePanelScript(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buyerKey, keys[0]) || !bytes.Equal(vendorKey, keys[1]) {
+		t.Error("Parsed the wrong buyer or vendor key")
+	}
+	if len(moderatorKeys) != 3 || threshold != 2 {
+		t.Errorf("Expected a 2 of 3 panel, got %d of %d", threshold, len(moderatorKeys))
+	}
+	for i, key := range moderatorKeys {
+		if !bytes.Equal(key, keys[2+i]) {
+			t.Errorf("Parsed the wrong key for moderator %d", i)
+		}
+	}
+	lead, ok := panelLeadKey(hex.EncodeToString(script))
+	if !ok || lead != hex.EncodeToString(keys[2]) {
+		t.Error("Failed to return the lead moderator's key")
+	}
+}
+
+func TestPanelScriptInvalid(t *testing.T) {
+	keys := serializedPubKeys(newPanelKeys(t, 4))
+	if _, err := PanelScript(keys[0], keys[1], keys[2:3], 1); err == nil {
+		t.Error("Built a panel with a single moderator")
+	}
+	if _, err := PanelScript(keys[0], keys[1], keys[2:], 0); err == nil {
+		t.Error("Built a panel with a threshold of zero")
+	}
+	if _, err := PanelScript(keys[0], keys[1], keys[2:], 3); err == nil {
+		t.Error("Built a panel with a threshold larger than the panel")
+	}
+
+	var addrPubKeys []*btcutil.AddressPubKey
+	for _, key := range keys[:3] {
+		k, err := btcutil.NewAddressPubKey(key, &chaincfg.TestNet3Params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrPubKeys = append(addrPubKeys, k)
+	}
+	multisig, err := txscript.MultiSigScript(addrPubKeys, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, _, err := ParsePanelScript(multisig); err == nil {
+		t.Error("Parsed a plain multisig script as a panel script")
+	}
+	if _, ok := panelLeadKey(hex.EncodeToString(multisig)); ok {
+		t.Error("Returned a lead moderator key for a plain multisig script")
+	}
+}
+
+func TestPanelApproved(t *testing.T) {
+	contract := &pb.RicardianContract{
+		BuyerOrder: &pb.Order{
+			Payment: &pb.Order_Payment{
+				Moderator:          "mod1",
+				ModeratorPanel:     []string{"mod1", "mod2", "mod3"},
+				ModeratorThreshold: 2,
+			},
+		},
+	}
+	if PanelApproved(contract) {
+		t.Error("Panel approved without a resolution")
+	}
+	contract.DisputeResolution = new(pb.DisputeResolution)
+	if PanelApproved(contract) {
+		t.Error("Panel approved with only the lead moderator")
+	}
+	contract.DisputeEndorsements = []*pb.DisputeEndorsement{{ModeratorID: "mod3"}}
+	if !PanelApproved(contract) {
+		t.Error("Panel not approved after reaching the threshold")
+	}
+	contract.BuyerOrder.Payment.ModeratorPanel = nil
+	contract.DisputeEndorsements = nil
+	if !PanelApproved(contract) {
+		t.Error("Single moderator orders should always be approved")
+	}
+}
+
+func TestPanelSigScriptsSpend(t *testing.T) {
+	keys := newPanelKeys(t, 5)
+	buyer, mod1, mod3 := keys[0], keys[2], keys[4]
+	pubKeys := serializedPubKeys(keys)
+	redeemScript, err := PanelScript(pubKeys[0], pubKeys[1], pubKeys[2:], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := btcutil.NewAddressScriptHash(redeemScript, &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil))
+	tx.AddTxOut(wire.NewTxOut(10000, pkScript))
+	sign := func(key *btcec.PrivateKey) []byte {
+		sig, err := txscript.RawTxInSignature(tx, 0, redeemScript, txscript.SigHashAll, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sig
+	}
+
+	// The lead and the third moderator agree, the second moderator stays out of it
+	contract := &pb.RicardianContract{
+		BuyerOrder: &pb.Order{
+			Payment: &pb.Order_Payment{
+				Moderator:          "mod1",
+				ModeratorPanel:     []string{"mod1", "mod2", "mod3"},
+				ModeratorThreshold: 2,
+			},
+		},
+		DisputeResolution: &pb.DisputeResolution{
+			Payout: &pb.DisputeResolution_Payout{
+				Sigs: []*pb.BitcoinSignature{{InputIndex: 0, Signature: sign(mod1)}},
+			},
+		},
+		DisputeEndorsements: []*pb.DisputeEndorsement{
+			{ModeratorID: "mod3", Sigs: []*pb.BitcoinSignature{{InputIndex: 0, Signature: sign(mod3)}}},
+		},
+	}
+	mySigs := []spvwallet.Signature{{InputIndex: 0, Signature: sign(buyer)}}
+	sigScripts, err := panelSigScripts(contract, mySigs, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	push, err := txscript.NewScriptBuilder().AddData(redeemScript).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.TxIn[0].SignatureScript = append(sigScripts[0], push...)
+
+	vm, err := txscript.NewEngine(pkScript, tx, 0, txscript.StandardVerifyFlags, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Errorf("Dispute payout failed to validate: %s", err)
+	}
+
+	contract.DisputeEndorsements = nil
+	if _, err := panelSigScripts(contract, mySigs, 1); err == nil {
+		t.Error("Built signature scripts without enough moderator signatures")
+	}
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	btc "github.com/btcsuite/btcutil"
+)
+
+/* Returns the address the vendor should be paid out to. If the payout address rotation
+   policy is enabled each order gets a fresh address derived from the wallet's HD keychain
+   and the derivation is returned so it can be recorded on the order. Wallets that
+   can't do HD derivation fall back to the current (static) address. */
+func (n *OpenBazaarNode) PayoutAddress() (btc.Address, *pb.AddressDerivation) {
+	settings, err := n.Datastore.Settings().Get()
+	if err != nil || settings.RotatePayoutAddrs == nil || !*settings.RotatePayoutAddrs {
+		return n.Wallet.CurrentAddress(spvwallet.EXTERNAL), nil
+	}
+	addr, derivation, err := n.newDerivedAddress()
+	if err != nil {
+		log.Warningf("Falling back to static payout address: %s", err.Error())
+		return n.Wallet.CurrentAddress(spvwallet.EXTERNAL), nil
+	}
+	return addr, derivation
+}
+
+// Returns a new external address. The derivation is nil if the wallet isn't an HD wallet.
+func (n *OpenBazaarNode) NewPaymentAddress() (btc.Address, *pb.AddressDerivation) {
+	addr, derivation, err := n.newDerivedAddress()
+	if err != nil {
+		return n.Wallet.NewAddress(spvwallet.EXTERNAL), nil
+	}
+	return addr, derivation
+}
+
+func (n *OpenBazaarNode) newDerivedAddress() (btc.Address, *pb.AddressDerivation, error) {
+	hdWallet, ok := n.Wallet.(bitcoin.HDWallet)
+	if !ok {
+		return nil, nil, errors.New("Wallet does not support HD derivation")
+	}
+	addr, index, err := hdWallet.NewAddressWithIndex(spvwallet.EXTERNAL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, &pb.AddressDerivation{Index: index}, nil
+}
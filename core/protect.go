@@ -0,0 +1,88 @@
+package core
+
+import (
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+)
+
+// The tag used to protect the connections to our store's moderators
+const ModeratorProtectTag = "ob-moderator"
+
+/* Implemented by hosts which can keep connections from being trimmed, like the routed host.
+   Nothing trims connections in this version of the node, so this only records which peers
+   matter until a connection manager is added to the host. */
+type connProtector interface {
+	Protect(p peer.ID, tag string)
+	Unprotect(p peer.ID, tag string) bool
+}
+
+// The tag used to protect the connection to the other party of an order
+func OrderProtectTag(orderId string) string {
+	return "ob-order-" + orderId
+}
+
+// Keep the connection to a peer open until UnprotectPeer is called with the same tag
+func (n *OpenBazaarNode) ProtectPeer(peerId, tag string) {
+	cp, ok := n.IpfsNode.PeerHost.(connProtector)
+	if !ok {
+		return
+	}
+	p, err := peer.IDB58Decode(peerId)
+	if err != nil {
+		return
+	}
+	cp.Protect(p, tag)
+}
+
+// Allow the connection to a peer to be trimmed once none of its tags are left
+func (n *OpenBazaarNode) UnprotectPeer(peerId, tag string) {
+	cp, ok := n.IpfsNode.PeerHost.(connProtector)
+	if !ok {
+		return
+	}
+	p, err := peer.IDB58Decode(peerId)
+	if err != nil {
+		return
+	}
+	cp.Unprotect(p, tag)
+}
+
+/* Protect the connections to our moderators and to the other party of each open
+   order. Protection is not persisted so this is run at startup. Order connections
+   are released again when the order completes or is canceled, declined or refunded. */
+func (n *OpenBazaarNode) ProtectConnections() error {
+	settings, err := n.Datastore.Settings().Get()
+	if err == nil && settings.StoreModerators != nil {
+		for _, mod := range *settings.StoreModerators {
+			n.ProtectPeer(mod, ModeratorProtectTag)
+		}
+	}
+	sales, _, err := n.Datastore.Sales().GetAll(openOrderStates, "", false, false, -1, []string{})
+	if err != nil {
+		return err
+	}
+	for _, s := range sales {
+		n.ProtectPeer(s.BuyerId, OrderProtectTag(s.OrderId))
+	}
+	purchases, _, err := n.Datastore.Purchases().GetAll(openOrderStates, "", false, false, -1, []string{})
+	if err != nil {
+		return err
+	}
+	for _, p := range purchases {
+		n.ProtectPeer(p.VendorId, OrderProtectTag(p.OrderId))
+	}
+	return nil
+}
+
+// Orders which still need the other party to act
+var openOrderStates = []pb.OrderState{
+	pb.OrderState_PENDING,
+	pb.OrderState_AWAITING_PAYMENT,
+	pb.OrderState_PENDING_SETTLEMENT,
+	pb.OrderState_AWAITING_PICKUP,
+	pb.OrderState_AWAITING_FULFILLMENT,
+	pb.OrderState_PARTIALLY_FULFILLED,
+	pb.OrderState_FULFILLED,
+	pb.OrderState_DISPUTED,
+	pb.OrderState_DECIDED,
+}
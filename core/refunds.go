@@ -118,6 +118,7 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 	}
 	n.SendRefund(contract.BuyerOrder.BuyerID.PeerID, contract)
 	n.Datastore.Sales().Put(orderId, *contract, pb.OrderState_REFUNDED, true)
+	n.UnprotectPeer(contract.BuyerOrder.BuyerID.PeerID, OrderProtectTag(orderId))
 	return nil
 }
 
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"sort"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// The number of most recent orders used to compute the typical response time
+const ResponseTimeWindow = 50
+
+/* Record how long it took us to first act on a sale, measured from the buyer's
+   order timestamp, and refresh the typical response time on our profile. Online
+   orders are confirmed automatically so for those the first response is the
+   fulfillment. Only the first response to an order is recorded. */
+func (n *OpenBazaarNode) recordResponseTime(contract *pb.RicardianContract, orderId string) error {
+	if err := putResponseTime(n.Datastore.ResponseTimes(), contract, orderId, time.Now()); err != nil {
+		return err
+	}
+	return n.updateResponseTime()
+}
+
+/* Remove an order from the response time statistic. Disputed and cancelled orders
+   say little about how responsive we are. */
+func (n *OpenBazaarNode) ExcludeResponseTime(orderId string) error {
+	if err := n.Datastore.ResponseTimes().Delete(orderId); err != nil {
+		return err
+	}
+	return n.updateResponseTime()
+}
+
+func (n *OpenBazaarNode) updateResponseTime() error {
+	typical, err := typicalResponseTime(n.Datastore.ResponseTimes())
+	if err != nil {
+		return err
+	}
+	profile, err := n.GetProfile()
+	if err != nil {
+		return err
+	}
+	if profile.Stats == nil {
+		profile.Stats = new(pb.Profile_Stats)
+	}
+	seconds := uint32(typical / time.Second)
+	if profile.Stats.TypicalResponseTime == seconds {
+		return nil
+	}
+	profile.Stats.TypicalResponseTime = seconds
+	return n.UpdateProfile(&profile)
+}
+
+func putResponseTime(times repo.ResponseTimes, contract *pb.RicardianContract, orderId string, now time.Time) error {
+	placed, err := ptypes.Timestamp(contract.BuyerOrder.Timestamp)
+	if err != nil {
+		return err
+	}
+	responseTime := now.Sub(placed)
+	if responseTime < 0 {
+		responseTime = 0
+	}
+	return times.Put(orderId, responseTime, now)
+}
+
+// The median response time over the most recent orders
+func typicalResponseTime(times repo.ResponseTimes) (time.Duration, error) {
+	recent, err := times.GetRecent(ResponseTimeWindow)
+	if err != nil {
+		return 0, err
+	}
+	return medianDuration(recent), nil
+}
+
+func medianDuration(times []time.Duration) time.Duration {
+	if len(times) == 0 {
+		return 0
+	}
+	sorted := make(durationSlice, len(times))
+	copy(sorted, times)
+	sort.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+type durationSlice []time.Duration
+
+func (d durationSlice) Len() int           { return len(d) }
+func (d durationSlice) Less(i, j int) bool { return d[i] < d[j] }
+func (d durationSlice) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestMedianDuration(t *testing.T) {
+	if medianDuration(nil) != 0 {
+		t.Error("Median of no response times should be zero")
+	}
+	odd := []time.Duration{time.Hour * 30, time.Minute, time.Hour}
+	if m := medianDuration(odd); m != time.Hour {
+		t.Errorf("Expected one hour, got %s", m)
+	}
+	even := []time.Duration{time.Hour * 4, time.Hour, time.Hour * 2, time.Hour * 500}
+	if m := medianDuration(even); m != time.Hour*3 {
+		t.Errorf("Expected three hours, got %s", m)
+	}
+	if odd[0] != time.Hour*30 {
+		t.Error("medianDuration modified its input")
+	}
+}
+
+type memResponseTimes struct {
+	times   map[string]time.Duration
+	order   []string
+	failPut bool
+}
+
+func (m *memResponseTimes) Put(orderID string, responseTime time.Duration, timestamp time.Time) error {
+	if m.failPut {
+		return errors.New("disk full")
+	}
+	if _, ok := m.times[orderID]; ok {
+		return nil
+	}
+	m.times[orderID] = responseTime
+	m.order = append(m.order, orderID)
+	return nil
+}
+
+func (m *memResponseTimes) GetRecent(limit int) ([]time.Duration, error) {
+	var ret []time.Duration
+	for i := len(m.order) - 1; i >= 0 && len(ret) < limit; i-- {
+		if d, ok := m.times[m.order[i]]; ok {
+			ret = append(ret, d)
+		}
+	}
+	return ret, nil
+}
+
+func (m *memResponseTimes) Delete(orderID string) error {
+	delete(m.times, orderID)
+	return nil
+}
+
+func TestPutResponseTime(t *testing.T) {
+	now := time.Now()
+	placedAgo := func(d time.Duration) *pb.RicardianContract {
+		ts, _ := ptypes.TimestampProto(now.Add(-d))
+		return &pb.RicardianContract{BuyerOrder: &pb.Order{Timestamp: ts}}
+	}
+	times := &memResponseTimes{times: make(map[string]time.Duration)}
+	for orderId, d := range map[string]time.Duration{"a": time.Hour, "b": time.Hour * 3, "c": time.Hour * 100} {
+		if err := putResponseTime(times, placedAgo(d), orderId, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A later response to the same order doesn't count
+	if err := putResponseTime(times, placedAgo(time.Hour*200), "a", now); err != nil {
+		t.Fatal(err)
+	}
+	typical, err := typicalResponseTime(times)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typical != time.Hour*3 {
+		t.Errorf("Expected three hours, got %s", typical)
+	}
+
+	// Orders from a buyer with a clock ahead of ours respond instantly
+	if err := putResponseTime(times, placedAgo(-time.Hour), "d", now); err != nil {
+		t.Fatal(err)
+	}
+	if times.times["d"] != 0 {
+		t.Error("Negative response time recorded")
+	}
+
+	times.failPut = true
+	if err := putResponseTime(times, placedAgo(time.Hour), "e", now); err == nil {
+		t.Error("Write error was not returned")
+	}
+}
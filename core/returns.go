@@ -0,0 +1,94 @@
+package core
+
+import (
+	"errors"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// The amounts each party would receive if a dispute were closed with a given split
+type DisputePayoutPreview struct {
+	RestockingFee    float32 `json:"restockingFee"`
+	BuyerPercentage  float32 `json:"buyerPercentage"`
+	VendorPercentage float32 `json:"vendorPercentage"`
+	BuyerAmount      uint64  `json:"buyerAmount"`
+	VendorAmount     uint64  `json:"vendorAmount"`
+	ModeratorFee     uint64  `json:"moderatorFee"`
+}
+
+/* Returns the restocking fee the vendor set for returns of the items in this order.
+   If the order contains several listings the highest fee is used. */
+func RestockingFee(contract *pb.RicardianContract) float32 {
+	var fee float32
+	for _, listing := range contract.VendorListings {
+		if listing.ReturnInstructions != nil && listing.ReturnInstructions.RestockingFee > fee {
+			fee = listing.ReturnInstructions.RestockingFee
+		}
+	}
+	return fee
+}
+
+// Moves the restocking fee on the buyer's share of a refund over to the vendor
+func ApplyRestockingFee(buyerPercentage, vendorPercentage, fee float32) (float32, float32) {
+	if fee <= 0 || buyerPercentage <= 0 {
+		return buyerPercentage, vendorPercentage
+	}
+	kept := buyerPercentage * fee / 100
+	return buyerPercentage - kept, vendorPercentage + kept
+}
+
+/* Preview the payout of a dispute we are moderating. The restocking fee, if the vendor
+   set one, is deducted from the buyer's share. Amounts are in satoshi and do not include
+   the network fee, which is taken from each output in proportion to its value. */
+func (n *OpenBazaarNode) PreviewDisputePayout(orderId string, buyerPercentage, vendorPercentage float32) (*DisputePayoutPreview, error) {
+	if buyerPercentage+vendorPercentage != 100 {
+		return nil, errors.New("Payout percentages must sum to 100")
+	}
+	buyerContract, vendorContract, _, _, buyerOutpoints, vendorOutpoints, state, err := n.Datastore.Cases().GetPayoutDetails(orderId)
+	if err != nil {
+		return nil, ErrCaseNotFound
+	}
+	if state != pb.OrderState_DISPUTED {
+		return nil, errors.New("A dispute for this order is not open")
+	}
+	return disputePayout(buyerContract, vendorContract, buyerOutpoints, vendorOutpoints, buyerPercentage, vendorPercentage, n.GetModeratorFee)
+}
+
+/* Works out the payout of a dispute. CloseDispute pays exactly what this returns so the
+   preview always matches the resolution. The vendor's contract is preferred for the
+   restocking fee since it is the one the vendor signed. */
+func disputePayout(buyerContract, vendorContract *pb.RicardianContract, buyerOutpoints, vendorOutpoints []*pb.Outpoint, buyerPercentage, vendorPercentage float32, moderatorFee func(uint64) (uint64, error)) (*DisputePayoutPreview, error) {
+	preview := new(DisputePayoutPreview)
+	if vendorContract != nil {
+		preview.RestockingFee = RestockingFee(vendorContract)
+	} else if buyerContract != nil {
+		preview.RestockingFee = RestockingFee(buyerContract)
+	}
+	preview.BuyerPercentage, preview.VendorPercentage = ApplyRestockingFee(buyerPercentage, vendorPercentage, preview.RestockingFee)
+
+	var totalOut uint64
+	for _, o := range disputeOutpoints(buyerOutpoints, vendorOutpoints, preview.BuyerPercentage, preview.VendorPercentage) {
+		totalOut += o.Value
+	}
+	if totalOut == 0 {
+		return preview, nil
+	}
+
+	var err error
+	preview.ModeratorFee, err = moderatorFee(totalOut)
+	if err != nil {
+		return nil, err
+	}
+	remaining := float64(totalOut) - float64(preview.ModeratorFee)
+	preview.BuyerAmount = uint64(remaining * (float64(preview.BuyerPercentage) / 100))
+	preview.VendorAmount = uint64(remaining * (float64(preview.VendorPercentage) / 100))
+	return preview, nil
+}
+
+// The outpoints a dispute payout spends. The party receiving the larger share reported them.
+func disputeOutpoints(buyerOutpoints, vendorOutpoints []*pb.Outpoint, buyerPercentage, vendorPercentage float32) []*pb.Outpoint {
+	if vendorPercentage > buyerPercentage {
+		return vendorOutpoints
+	}
+	return buyerOutpoints
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestRestockingFee(t *testing.T) {
+	contract := &pb.RicardianContract{
+		VendorListings: []*pb.Listing{
+			{Slug: "a"},
+			{Slug: "b", ReturnInstructions: &pb.Listing_ReturnInstructions{RestockingFee: 15}},
+			{Slug: "c", ReturnInstructions: &pb.Listing_ReturnInstructions{RestockingFee: 5}},
+		},
+	}
+	if fee := RestockingFee(contract); fee != 15 {
+		t.Errorf("Expected a restocking fee of 15, got %f", fee)
+	}
+	if fee := RestockingFee(&pb.RicardianContract{VendorListings: []*pb.Listing{{Slug: "a"}}}); fee != 0 {
+		t.Errorf("Listings without return instructions should have no restocking fee, got %f", fee)
+	}
+}
+
+func TestApplyRestockingFee(t *testing.T) {
+	buyer, vendor := ApplyRestockingFee(100, 0, 20)
+	if buyer != 80 || vendor != 20 {
+		t.Errorf("Full refund: expected 80/20, got %f/%f", buyer, vendor)
+	}
+	buyer, vendor = ApplyRestockingFee(50, 50, 10)
+	if buyer != 45 || vendor != 55 {
+		t.Errorf("Partial refund: expected 45/55, got %f/%f", buyer, vendor)
+	}
+	buyer, vendor = ApplyRestockingFee(0, 100, 10)
+	if buyer != 0 || vendor != 100 {
+		t.Errorf("No refund: expected 0/100, got %f/%f", buyer, vendor)
+	}
+	buyer, vendor = ApplyRestockingFee(60, 40, 0)
+	if buyer != 60 || vendor != 40 {
+		t.Errorf("No fee: expected 60/40, got %f/%f", buyer, vendor)
+	}
+}
+
+func TestDisputePayout(t *testing.T) {
+	contract := &pb.RicardianContract{
+		VendorListings: []*pb.Listing{
+			{Slug: "a", ReturnInstructions: &pb.Listing_ReturnInstructions{RestockingFee: 20}},
+		},
+	}
+	buyerOutpoints := []*pb.Outpoint{{Hash: "a", Index: 0, Value: 60000}, {Hash: "b", Index: 1, Value: 40000}}
+	vendorOutpoints := []*pb.Outpoint{{Hash: "c", Index: 0, Value: 50000}}
+	moderatorFee := func(total uint64) (uint64, error) {
+		return total / 100, nil
+	}
+
+	// A full refund moves the restocking fee to the vendor, so the vendor is paid too
+	payout, err := disputePayout(contract, contract, buyerOutpoints, vendorOutpoints, 100, 0, moderatorFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payout.BuyerPercentage != 80 || payout.VendorPercentage != 20 {
+		t.Errorf("Expected an 80/20 split, got %f/%f", payout.BuyerPercentage, payout.VendorPercentage)
+	}
+	if payout.ModeratorFee != 1000 || payout.BuyerAmount != 79200 || payout.VendorAmount != 19800 {
+		t.Errorf("Unexpected amounts: moderator %d, buyer %d, vendor %d", payout.ModeratorFee, payout.BuyerAmount, payout.VendorAmount)
+	}
+
+	// When the vendor gets the larger share the vendor's outpoints are spent
+	payout, err = disputePayout(contract, contract, buyerOutpoints, vendorOutpoints, 25, 75, moderatorFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payout.BuyerPercentage != 20 || payout.VendorPercentage != 80 {
+		t.Errorf("Expected a 20/80 split, got %f/%f", payout.BuyerPercentage, payout.VendorPercentage)
+	}
+	if payout.ModeratorFee != 500 || payout.BuyerAmount != 9900 || payout.VendorAmount != 39600 {
+		t.Errorf("Unexpected amounts: moderator %d, buyer %d, vendor %d", payout.ModeratorFee, payout.BuyerAmount, payout.VendorAmount)
+	}
+
+	// Without the vendor's contract the buyer's copy of the listing is used
+	payout, err = disputePayout(contract, nil, buyerOutpoints, nil, 50, 50, moderatorFee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payout.RestockingFee != 20 || payout.BuyerPercentage != 40 {
+		t.Errorf("Restocking fee not applied from the buyer's contract: %+v", payout)
+	}
+}
+
+func TestDisputeOutpoints(t *testing.T) {
+	buyer := []*pb.Outpoint{{Hash: "buyer"}}
+	vendor := []*pb.Outpoint{{Hash: "vendor"}}
+	for _, c := range []struct {
+		buyerPercentage, vendorPercentage float32
+		expected                          string
+	}{
+		{100, 0, "buyer"},
+		{0, 100, "vendor"},
+		{50, 50, "buyer"},
+		{40, 60, "vendor"},
+	} {
+		if o := disputeOutpoints(buyer, vendor, c.buyerPercentage, c.vendorPercentage); o[0].Hash != c.expected {
+			t.Errorf("%f/%f: expected the %s outpoints, got the %s outpoints", c.buyerPercentage, c.vendorPercentage, c.expected, o[0].Hash)
+		}
+	}
+}
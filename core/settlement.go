@@ -0,0 +1,208 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/spvwallet"
+)
+
+const settlementInterval = time.Minute * 10
+
+var ErrBatchingUnsupported = errors.New("The wallet cannot pay batched orders")
+
+type pendingSettlement struct {
+	orderId string
+	address string
+	amount  int64
+	placed  time.Time
+}
+
+// The purchases from one vendor which are waiting to be paid together
+type settlementBatch struct {
+	vendorId string
+	orders   []pendingSettlement
+	total    int64
+}
+
+/* Pays for purchases from the same vendor in a single transaction. Orders the buyer
+   chose to batch wait in PENDING_SETTLEMENT while their running balance is tracked
+   per vendor. Once a vendor's balance reaches the threshold, or the oldest of its
+   orders has waited maxAge, every order in the batch is paid on-chain at once. If
+   the settlement can't be paid the orders go back to PENDING_SETTLEMENT to be tried
+   again on the next run, and the buyer is notified once so they can pay them
+   individually instead. */
+type Settler struct {
+	db        repo.Datastore
+	wallet    bitcoin.BitcoinWallet
+	broadcast chan interface{}
+	threshold int64
+	maxAge    time.Duration
+	failed    map[string]bool
+}
+
+func NewSettler(db repo.Datastore, wallet bitcoin.BitcoinWallet, broadcast chan interface{}, threshold int64, maxAge time.Duration) *Settler {
+	return &Settler{db, wallet, broadcast, threshold, maxAge, make(map[string]bool)}
+}
+
+func (s *Settler) Run() {
+	tick := time.NewTicker(settlementInterval)
+	defer tick.Stop()
+	s.Settle(time.Now())
+	for range tick.C {
+		s.Settle(time.Now())
+	}
+}
+
+// Settle every batch which has reached the threshold or max age
+func (s *Settler) Settle(now time.Time) {
+	batches, err := s.pendingBatches()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, b := range dueBatches(batches, s.threshold, s.maxAge, now) {
+		if err := s.settle(b); err != nil {
+			log.Errorf("Settlement of %d orders to %s failed: %s", len(b.orders), b.vendorId, err)
+		}
+	}
+}
+
+func (s *Settler) pendingBatches() ([]settlementBatch, error) {
+	purchases, _, err := s.db.Purchases().GetAll([]pb.OrderState{pb.OrderState_PENDING_SETTLEMENT}, "", true, false, -1, []string{})
+	if err != nil {
+		return nil, err
+	}
+	byVendor := make(map[string]*settlementBatch)
+	var vendors []string
+	for _, p := range purchases {
+		contract, state, funded, records, _, err := s.db.Purchases().GetByOrderId(p.OrderId)
+		if err != nil || funded || state != pb.OrderState_PENDING_SETTLEMENT {
+			continue
+		}
+		address := contract.BuyerOrder.Payment.Address
+		if contract.VendorOrderConfirmation != nil {
+			address = contract.VendorOrderConfirmation.PaymentAddress
+		}
+		amount := int64(repo.RequestedAmount(contract)) - int64(fundingReceived(records))
+		if amount <= 0 {
+			continue
+		}
+		b, ok := byVendor[p.VendorId]
+		if !ok {
+			b = &settlementBatch{vendorId: p.VendorId}
+			byVendor[p.VendorId] = b
+			vendors = append(vendors, p.VendorId)
+		}
+		b.orders = append(b.orders, pendingSettlement{p.OrderId, address, amount, p.Timestamp})
+		b.total += amount
+	}
+	var batches []settlementBatch
+	for _, v := range vendors {
+		batches = append(batches, *byVendor[v])
+	}
+	return batches, nil
+}
+
+// Returns the batches which should be settled now
+func dueBatches(batches []settlementBatch, threshold int64, maxAge time.Duration, now time.Time) []settlementBatch {
+	var due []settlementBatch
+	for _, b := range batches {
+		if len(b.orders) == 0 {
+			continue
+		}
+		oldest := b.orders[0].placed
+		for _, o := range b.orders {
+			if o.placed.Before(oldest) {
+				oldest = o.placed
+			}
+		}
+		if b.total >= threshold || now.Sub(oldest) >= maxAge {
+			due = append(due, b)
+		}
+	}
+	return due
+}
+
+/* The orders leave PENDING_SETTLEMENT before the transaction is sent so a batch is
+   never paid twice. When the payment arrives the transaction listener moves them on
+   as it does for any other payment. */
+func (s *Settler) settle(b settlementBatch) error {
+	var orderIds []string
+	for _, o := range b.orders {
+		orderIds = append(orderIds, o.orderId)
+	}
+	sort.Strings(orderIds)
+	if moved, err := s.setState(b.orders, pb.OrderState_AWAITING_PAYMENT); err != nil {
+		if _, rerr := s.setState(moved, pb.OrderState_PENDING_SETTLEMENT); rerr != nil {
+			log.Error(rerr)
+		}
+		return err
+	}
+
+	txid, err := s.pay(b)
+	if err != nil {
+		if _, rerr := s.setState(b.orders, pb.OrderState_PENDING_SETTLEMENT); rerr != nil {
+			log.Error(rerr)
+		}
+		key := strings.Join(orderIds, ",")
+		if !s.failed[key] {
+			s.failed[key] = true
+			n := notifications.SettlementFailedNotification{b.vendorId, orderIds, err.Error()}
+			s.broadcast <- n
+			s.db.Notifications().Put(notifications.Wrap(n), time.Now())
+		}
+		return err
+	}
+	log.Infof("Settled %d orders to %s in %s", len(b.orders), b.vendorId, txid)
+	s.db.TxMetadata().PutGeneratedLabel(txid, fmt.Sprintf("Settlement of %d orders", len(b.orders)))
+	n := notifications.SettlementNotification{txid, b.vendorId, orderIds, uint64(b.total)}
+	s.broadcast <- n
+	s.db.Notifications().Put(notifications.Wrap(n), time.Now())
+	return nil
+}
+
+// Moves the orders to the given state. Returns the orders which were moved before any error.
+func (s *Settler) setState(orders []pendingSettlement, state pb.OrderState) ([]pendingSettlement, error) {
+	for i, o := range orders {
+		contract, _, _, _, read, err := s.db.Purchases().GetByOrderId(o.orderId)
+		if err != nil {
+			return orders[:i], err
+		}
+		if err := s.db.Purchases().Put(o.orderId, *contract, state, read); err != nil {
+			return orders[:i], err
+		}
+	}
+	return orders, nil
+}
+
+func (s *Settler) pay(b settlementBatch) (string, error) {
+	bw, ok := s.wallet.(bitcoin.BatchWallet)
+	if !ok {
+		return "", ErrBatchingUnsupported
+	}
+	var outs []spvwallet.TransactionOutput
+	for _, o := range b.orders {
+		addr, err := s.wallet.DecodeAddress(o.address)
+		if err != nil {
+			return "", err
+		}
+		script, err := s.wallet.AddressToScript(addr)
+		if err != nil {
+			return "", err
+		}
+		outs = append(outs, spvwallet.TransactionOutput{ScriptPubKey: script, Value: o.amount})
+	}
+	txid, err := bw.SpendMany(outs, spvwallet.NORMAL)
+	if err != nil {
+		return "", err
+	}
+	return txid.String(), nil
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/bitcoin"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	btc "github.com/btcsuite/btcutil"
+)
+
+func TestDueBatches(t *testing.T) {
+	now := time.Now()
+	batches := []settlementBatch{
+		{
+			vendorId: "small",
+			orders:   []pendingSettlement{{orderId: "a", amount: 100, placed: now.Add(-time.Hour)}},
+			total:    100,
+		},
+		{
+			vendorId: "large",
+			orders: []pendingSettlement{
+				{orderId: "b", amount: 600, placed: now.Add(-time.Minute)},
+				{orderId: "c", amount: 600, placed: now},
+			},
+			total: 1200,
+		},
+		{
+			vendorId: "old",
+			orders: []pendingSettlement{
+				{orderId: "d", amount: 100, placed: now},
+				{orderId: "e", amount: 100, placed: now.Add(-time.Hour * 25)},
+			},
+			total: 200,
+		},
+	}
+	due := dueBatches(batches, 1000, time.Hour*24, now)
+	if len(due) != 2 {
+		t.Fatalf("Expected 2 batches to be due, got %d", len(due))
+	}
+	if due[0].vendorId != "large" {
+		t.Error("Batch over the threshold was not settled")
+	}
+	if due[1].vendorId != "old" {
+		t.Error("Batch past the max age was not settled")
+	}
+}
+
+// A wallet which records the outputs it is asked to pay
+type batchWallet struct {
+	bitcoin.BitcoinWallet
+	err   error
+	spent []spvwallet.TransactionOutput
+}
+
+func (w *batchWallet) DecodeAddress(addr string) (btc.Address, error) {
+	return btc.NewAddressPubKeyHash(make([]byte, 20), &chaincfg.TestNet3Params)
+}
+
+func (w *batchWallet) AddressToScript(addr btc.Address) ([]byte, error) {
+	return addr.ScriptAddress(), nil
+}
+
+func (w *batchWallet) SpendMany(outs []spvwallet.TransactionOutput, feeLevel spvwallet.FeeLevel) (*chainhash.Hash, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	w.spent = append(w.spent, outs...)
+	return &chainhash.Hash{}, nil
+}
+
+func settlementDatastore(t *testing.T) (repo.Datastore, func()) {
+	dir, err := ioutil.TempDir("", "settlement")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.MkdirAll(path.Join(dir, "datastore"), os.ModePerm)
+	ds, err := db.Create(dir, "", false)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	if err := ds.Config().Init("mnemonic", []byte("key"), ""); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	ds.Purchases().Put("a", *unpaidContract(now.Add(-time.Hour * 48)), pb.OrderState_PENDING_SETTLEMENT, false)
+	ds.Purchases().Put("b", *unpaidContract(now), pb.OrderState_PENDING_SETTLEMENT, false)
+	return ds, func() {
+		ds.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func purchaseStates(t *testing.T, ds repo.Datastore, expected pb.OrderState) {
+	for _, orderId := range []string{"a", "b"} {
+		_, state, _, _, _, err := ds.Purchases().GetByOrderId(orderId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != expected {
+			t.Errorf("%s: expected %s, got %s", orderId, expected, state)
+		}
+	}
+}
+
+func TestSettle(t *testing.T) {
+	ds, cleanup := settlementDatastore(t)
+	defer cleanup()
+
+	wallet := &batchWallet{}
+	broadcast := make(chan interface{}, 10)
+	NewSettler(ds, wallet, broadcast, 1000000, time.Hour*24).Settle(time.Now())
+
+	purchaseStates(t, ds, pb.OrderState_AWAITING_PAYMENT)
+	if len(wallet.spent) != 2 || wallet.spent[0].Value != 10000 || wallet.spent[1].Value != 10000 {
+		t.Errorf("Unexpected outputs %v", wallet.spent)
+	}
+	if len(broadcast) != 1 {
+		t.Fatalf("Expected one notification, got %d", len(broadcast))
+	}
+	n, ok := (<-broadcast).(notifications.SettlementNotification)
+	if !ok || len(n.OrderIds) != 2 || n.Amount != 20000 {
+		t.Errorf("Unexpected notification %v", n)
+	}
+}
+
+func TestSettleFailure(t *testing.T) {
+	ds, cleanup := settlementDatastore(t)
+	defer cleanup()
+
+	wallet := &batchWallet{err: errors.New("insufficient funds")}
+	broadcast := make(chan interface{}, 10)
+	s := NewSettler(ds, wallet, broadcast, 1000000, time.Hour*24)
+	s.Settle(time.Now())
+	s.Settle(time.Now())
+
+	// The orders are left to be settled again
+	purchaseStates(t, ds, pb.OrderState_PENDING_SETTLEMENT)
+	batches, err := s.pendingBatches()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 1 || len(batches[0].orders) != 2 {
+		t.Errorf("Failed orders are not pending settlement %v", batches)
+	}
+
+	// The buyer is told once, not on every run
+	if len(broadcast) != 1 {
+		t.Fatalf("Expected one notification, got %d", len(broadcast))
+	}
+	n, ok := (<-broadcast).(notifications.SettlementFailedNotification)
+	if !ok || len(n.OrderIds) != 2 || n.Reason != "insufficient funds" {
+		t.Errorf("Unexpected notification %v", n)
+	}
+
+	// Once the wallet can pay, the batch settles
+	wallet.err = nil
+	s.Settle(time.Now())
+	purchaseStates(t, ds, pb.OrderState_AWAITING_PAYMENT)
+	if len(wallet.spent) != 2 {
+		t.Errorf("Expected the batch to be paid, got %v", wallet.spent)
+	}
+}
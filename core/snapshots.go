@@ -0,0 +1,74 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+/* Returns a preview of an ordered item built from the listing in the contract. Buyers
+   can render an order summary from it without fetching the listing again, which may
+   have changed or been removed since the order was placed. */
+func NewItemSnapshot(item *pb.Order_Item, contract *pb.RicardianContract) (*pb.ItemSnapshot, error) {
+	l, err := ParseContractForListing(item.ListingHash, contract)
+	if err != nil {
+		return nil, fmt.Errorf("Listing not found in contract for item %s", item.ListingHash)
+	}
+	snapshot := &pb.ItemSnapshot{
+		ListingHash:     item.ListingHash,
+		Slug:            l.Slug,
+		Title:           l.Item.Title,
+		PricingCurrency: l.Metadata.PricingCurrency,
+		UnitPrice:       int64(l.Item.Price),
+		Quantity:        item.Quantity,
+		Options:         item.Options,
+	}
+	if len(l.Item.Images) > 0 {
+		snapshot.Thumbnail = l.Item.Images[0].Tiny
+	}
+	selectedSku, err := GetSelectedSku(l, item.Options)
+	if err != nil {
+		return nil, err
+	}
+	if selectedSku < len(l.Item.Skus) {
+		snapshot.UnitPrice += l.Item.Skus[selectedSku].Surcharge
+	}
+	return snapshot, nil
+}
+
+// Returns a snapshot of each item in the buyer's order
+func ItemSnapshots(contract *pb.RicardianContract) ([]*pb.ItemSnapshot, error) {
+	var snapshots []*pb.ItemSnapshot
+	for _, item := range contract.BuyerOrder.Items {
+		snapshot, err := NewItemSnapshot(item, contract)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+/* Check the item snapshots in a vendor's order confirmation match the listings in the
+   contract. Vendors running older versions don't send any, so an empty list is fine. */
+func validateItemSnapshots(contract *pb.RicardianContract) error {
+	snapshots := contract.VendorOrderConfirmation.Items
+	if len(snapshots) == 0 {
+		return nil
+	}
+	expected, err := ItemSnapshots(contract)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) != len(expected) {
+		return errors.New("Order confirmation does not contain a snapshot for each item")
+	}
+	for i, snapshot := range snapshots {
+		if !proto.Equal(snapshot, expected[i]) {
+			return fmt.Errorf("Order confirmation snapshot for item %s does not match the listing", snapshot.ListingHash)
+		}
+	}
+	return nil
+}
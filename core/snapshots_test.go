@@ -0,0 +1,89 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func newSnapshotContract(t *testing.T) *pb.RicardianContract {
+	listing := &pb.Listing{
+		Slug:     "shirt",
+		Metadata: &pb.Listing_Metadata{PricingCurrency: "USD"},
+		Item: &pb.Listing_Item{
+			Title:  "Shirt",
+			Price:  1000,
+			Images: []*pb.Listing_Item_Image{{Tiny: "QmTiny"}},
+			Options: []*pb.Listing_Item_Option{{
+				Name:     "Size",
+				Variants: []*pb.Listing_Item_Option_Variant{{Name: "Small"}, {Name: "Large"}},
+			}},
+			Skus: []*pb.Listing_Item_Sku{
+				{VariantCombo: []uint32{0}},
+				{VariantCombo: []uint32{1}, Surcharge: 250},
+			},
+		},
+	}
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mh, err := EncodeMultihash(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.RicardianContract{
+		VendorListings: []*pb.Listing{listing},
+		BuyerOrder: &pb.Order{
+			Items: []*pb.Order_Item{{
+				ListingHash: mh.B58String(),
+				Quantity:    2,
+				Options:     []*pb.Order_Item_Option{{Name: "size", Value: "large"}},
+			}},
+		},
+	}
+}
+
+func TestItemSnapshots(t *testing.T) {
+	contract := newSnapshotContract(t)
+	snapshots, err := ItemSnapshots(contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshots) != 1 {
+		t.Fatalf("Expected one snapshot, got %d", len(snapshots))
+	}
+	s := snapshots[0]
+	if s.Slug != "shirt" || s.Title != "Shirt" || s.Thumbnail != "QmTiny" || s.PricingCurrency != "USD" {
+		t.Errorf("Snapshot does not describe the listing: %+v", s)
+	}
+	if s.UnitPrice != 1250 {
+		t.Errorf("Expected the unit price to include the variant surcharge, got %d", s.UnitPrice)
+	}
+	if s.Quantity != 2 || len(s.Options) != 1 || s.Options[0].Value != "large" {
+		t.Errorf("Snapshot does not describe the ordered item: %+v", s)
+	}
+}
+
+func TestValidateItemSnapshots(t *testing.T) {
+	contract := newSnapshotContract(t)
+	contract.VendorOrderConfirmation = new(pb.OrderConfirmation)
+	if err := validateItemSnapshots(contract); err != nil {
+		t.Error("Confirmations without snapshots should be accepted")
+	}
+
+	snapshots, err := ItemSnapshots(contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract.VendorOrderConfirmation.Items = snapshots
+	if err := validateItemSnapshots(contract); err != nil {
+		t.Error(err)
+	}
+
+	snapshots[0].UnitPrice = 1
+	if err := validateItemSnapshots(contract); err == nil {
+		t.Error("Accepted a snapshot which does not match the listing")
+	}
+}
@@ -0,0 +1,276 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+	mh "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+)
+
+var unsafeFilenameChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+type siteListing struct {
+	Page     string
+	Title    string
+	Price    string
+	Thumb    string
+	Images   []string
+	Listing  *pb.Listing
+	JSONFile string
+}
+
+type siteFailure struct {
+	Slug  string
+	Error string
+}
+
+type siteData struct {
+	Profile  pb.Profile
+	Avatar   string
+	Header   string
+	Listings []siteListing
+	Failed   []siteFailure
+}
+
+/* Write a static, browsable copy of a peer's store to outputDir. The profile and each
+   listing are saved as JSON next to generated HTML pages, and the images they use are
+   downloaded into outputDir/images so the site can be shared with people who don't run
+   OpenBazaar. A listing that can't be fetched is skipped and noted on the index page. */
+func (n *OpenBazaarNode) ExportStaticSite(peerID, outputDir string) error {
+	if strings.HasPrefix(peerID, "@") {
+		var err error
+		peerID, err = n.Resolver.Resolve(peerID)
+		if err != nil {
+			return err
+		}
+	}
+	for _, dir := range []string{outputDir, path.Join(outputDir, "listings"), path.Join(outputDir, "images")} {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return err
+		}
+	}
+
+	var profile pb.Profile
+	var err error
+	if peerID == n.IpfsNode.Identity.Pretty() {
+		profile, err = n.GetProfile()
+	} else {
+		profile, err = n.FetchProfile(peerID, true)
+	}
+	if err != nil {
+		return err
+	}
+	m := jsonpb.Marshaler{Indent: "    "}
+	out, err := m.MarshalToString(&profile)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path.Join(outputDir, "profile.json"), []byte(out), os.ModePerm); err != nil {
+		return err
+	}
+
+	data := siteData{Profile: profile}
+	if profile.AvatarHashes != nil {
+		data.Avatar = n.exportImage(outputDir, profile.AvatarHashes.Small)
+	}
+	if profile.HeaderHashes != nil {
+		data.Header = n.exportImage(outputDir, profile.HeaderHashes.Large)
+	}
+
+	indexBytes, err := ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerID, "listings", "index.json")))
+	if err != nil {
+		return err
+	}
+	var index []listingData
+	if err := json.Unmarshal(indexBytes, &index); err != nil {
+		return err
+	}
+	usedNames := make(map[string]bool)
+	for i, ld := range index {
+		sl, err := n.fetchSignedListing(ld.Hash)
+		if err != nil {
+			log.Warningf("Static site export: failed to fetch listing %s: %s", ld.Slug, err)
+			data.Failed = append(data.Failed, siteFailure{ld.Slug, err.Error()})
+			continue
+		}
+		name := unsafeFilenameChars.ReplaceAllString(sl.Listing.Slug, "")
+		if name == "" {
+			name = "listing"
+		}
+		if usedNames[name] {
+			name = fmt.Sprintf("%s-%d", name, i)
+		}
+		usedNames[name] = true
+		site := siteListing{
+			Page:     path.Join("listings", name+".html"),
+			JSONFile: name + ".json",
+			Title:    sl.Listing.Item.Title,
+			Price:    formatPrice(sl.Listing.Metadata.PricingCurrency, sl.Listing.Item.Price),
+			Listing:  sl.Listing,
+		}
+		for j, img := range sl.Listing.Item.Images {
+			if j == 0 {
+				site.Thumb = n.exportImage(outputDir, img.Small)
+			}
+			if local := n.exportImage(outputDir, img.Medium); local != "" {
+				site.Images = append(site.Images, local)
+			}
+		}
+
+		out, err := m.MarshalToString(sl)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(path.Join(outputDir, "listings", site.JSONFile), []byte(out), os.ModePerm); err != nil {
+			return err
+		}
+		if err := writeSiteFile(path.Join(outputDir, site.Page), func(w io.Writer) error {
+			return listingPageTemplate.Execute(w, struct {
+				Profile pb.Profile
+				Listing siteListing
+			}{profile, site})
+		}); err != nil {
+			return err
+		}
+		data.Listings = append(data.Listings, site)
+	}
+	return writeSiteFile(path.Join(outputDir, "index.html"), func(w io.Writer) error {
+		return indexPageTemplate.Execute(w, data)
+	})
+}
+
+func (n *OpenBazaarNode) fetchSignedListing(hash string) (*pb.SignedListing, error) {
+	if _, err := mh.FromB58String(hash); err != nil {
+		return nil, fmt.Errorf("Invalid listing hash %s", hash)
+	}
+	listingBytes, err := ipfs.Cat(n.Context, hash)
+	if err != nil {
+		return nil, err
+	}
+	sl := new(pb.SignedListing)
+	if err := jsonpb.UnmarshalString(string(listingBytes), sl); err != nil {
+		return nil, err
+	}
+	if sl.Listing == nil || sl.Listing.Item == nil || sl.Listing.Metadata == nil {
+		return nil, fmt.Errorf("Listing %s is incomplete", hash)
+	}
+	sl.Hash = hash
+	return sl, nil
+}
+
+// Download an image into the site's image directory and return its path relative to
+// the site root, or an empty string if the image is not available
+func (n *OpenBazaarNode) exportImage(outputDir, hash string) string {
+	if _, err := mh.FromB58String(hash); err != nil {
+		return ""
+	}
+	local := path.Join("images", hash)
+	if _, err := os.Stat(path.Join(outputDir, local)); err == nil {
+		return local
+	}
+	img, err := ipfs.Cat(n.Context, hash)
+	if err != nil {
+		log.Warningf("Static site export: failed to fetch image %s: %s", hash, err)
+		return ""
+	}
+	if err := ioutil.WriteFile(path.Join(outputDir, local), img, os.ModePerm); err != nil {
+		log.Warningf("Static site export: failed to save image %s: %s", hash, err)
+		return ""
+	}
+	return local
+}
+
+func writeSiteFile(filename string, render func(io.Writer) error) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := render(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Formats a price given in the currency's smallest unit
+func formatPrice(currency string, amount uint64) string {
+	if strings.ToUpper(currency) == "BTC" {
+		return fmt.Sprintf("%d.%08d BTC", amount/100000000, amount%100000000)
+	}
+	return fmt.Sprintf("%d.%02d %s", amount/100, amount%100, currency)
+}
+
+const siteStyle = `<style>
+body { font-family: sans-serif; margin: 0 auto; max-width: 960px; padding: 1em; }
+.header { width: 100%; }
+.avatar { border-radius: 50%; width: 80px; }
+.listings { display: flex; flex-wrap: wrap; }
+.listing { margin: 0.5em; width: 220px; }
+.listing img, .images img { max-width: 100%; }
+.price { font-weight: bold; }
+.description { white-space: pre-wrap; }
+</style>`
+
+var indexPageTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Profile.Name}}</title>
+` + siteStyle + `
+</head>
+<body>
+{{if .Header}}<img class="header" src="{{.Header}}" alt="">{{end}}
+<h1>{{if .Avatar}}<img class="avatar" src="{{.Avatar}}" alt=""> {{end}}{{.Profile.Name}}</h1>
+{{with .Profile.Location}}<p>{{.}}</p>{{end}}
+{{with .Profile.ShortDescription}}<p>{{.}}</p>{{end}}
+{{with .Profile.About}}<p class="description">{{.}}</p>{{end}}
+<p>OpenBazaar peer ID: {{.Profile.PeerID}} &middot; <a href="profile.json">profile.json</a></p>
+<div class="listings">
+{{range .Listings}}<div class="listing">
+<a href="{{.Page}}">{{if .Thumb}}<img src="{{.Thumb}}" alt="">{{end}}<br>{{.Title}}</a>
+<div class="price">{{.Price}}</div>
+</div>
+{{else}}<p>This store has no listings.</p>
+{{end}}</div>
+{{if .Failed}}<h2>Listings which could not be exported</h2>
+<ul>
+{{range .Failed}}<li>{{.Slug}}: {{.Error}}</li>
+{{end}}</ul>
+{{end}}</body>
+</html>
+`))
+
+var listingPageTemplate = template.Must(template.New("listing").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Listing.Title}} - {{.Profile.Name}}</title>
+` + siteStyle + `
+</head>
+<body>
+<p><a href="../index.html">&larr; {{.Profile.Name}}</a></p>
+<h1>{{.Listing.Title}}</h1>
+<div class="price">{{.Listing.Price}}</div>
+<div class="images">
+{{range .Listing.Images}}<img src="../{{.}}" alt="">
+{{end}}</div>
+<p class="description">{{.Listing.Listing.Item.Description}}</p>
+{{with .Listing.Listing.Item.Options}}<h2>Options</h2>
+<ul>
+{{range .}}<li>{{.Name}}: {{range $i, $v := .Variants}}{{if $i}}, {{end}}{{$v.Name}}{{end}}</li>
+{{end}}</ul>
+{{end}}<p><a href="{{.Listing.JSONFile}}">Listing data (JSON)</a></p>
+</body>
+</html>
+`))
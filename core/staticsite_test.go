@@ -0,0 +1,71 @@
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestFormatPrice(t *testing.T) {
+	if p := formatPrice("USD", 1999); p != "19.99 USD" {
+		t.Errorf("Expected 19.99 USD, got %s", p)
+	}
+	if p := formatPrice("BTC", 150000000); p != "1.50000000 BTC" {
+		t.Errorf("Expected 1.50000000 BTC, got %s", p)
+	}
+}
+
+func TestIndexPageTemplate(t *testing.T) {
+	data := siteData{
+		Profile: pb.Profile{Name: "<script>alert(1)</script>", PeerID: "QmPeer"},
+		Listings: []siteListing{
+			{Page: "listings/shirt.html", Title: "Shirt", Price: "10.00 USD", Thumb: "images/QmThumb"},
+		},
+		Failed: []siteFailure{{Slug: "hat", Error: "not found"}},
+	}
+	var buf bytes.Buffer
+	if err := indexPageTemplate.Execute(&buf, data); err != nil {
+		t.Fatal(err)
+	}
+	page := buf.String()
+	if strings.Contains(page, "<script>") {
+		t.Error("Profile name was not escaped")
+	}
+	for _, want := range []string{`href="listings/shirt.html"`, `src="images/QmThumb"`, "10.00 USD", "hat: not found"} {
+		if !strings.Contains(page, want) {
+			t.Errorf("Index page is missing %q", want)
+		}
+	}
+}
+
+func TestListingPageTemplate(t *testing.T) {
+	listing := siteListing{
+		Title:    "Shirt",
+		Price:    "10.00 USD",
+		Images:   []string{"images/QmMedium"},
+		JSONFile: "shirt.json",
+		Listing: &pb.Listing{Item: &pb.Listing_Item{
+			Description: "A shirt",
+			Options: []*pb.Listing_Item_Option{{
+				Name:     "Size",
+				Variants: []*pb.Listing_Item_Option_Variant{{Name: "S"}, {Name: "L"}},
+			}},
+		}},
+	}
+	var buf bytes.Buffer
+	err := listingPageTemplate.Execute(&buf, struct {
+		Profile pb.Profile
+		Listing siteListing
+	}{pb.Profile{Name: "Store"}, listing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := buf.String()
+	for _, want := range []string{`href="../index.html"`, `src="../images/QmMedium"`, "Size: S, L", `href="shirt.json"`} {
+		if !strings.Contains(page, want) {
+			t.Errorf("Listing page is missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// The maximum length of a transaction label
+const TxLabelMaxCharacters = 280
+
+/* Set the label shown for a wallet transaction. Labels are only stored locally with
+   the rest of the transaction metadata, keyed by txid so they survive a rescan of the
+   wallet. An empty label removes the existing one. */
+func (n *OpenBazaarNode) SetTxLabel(txid, label string) error {
+	if _, err := chainhash.NewHashFromStr(txid); err != nil {
+		return fmt.Errorf("Invalid txid %s", txid)
+	}
+	label = strings.TrimSpace(label)
+	if utf8.RuneCountInString(label) > TxLabelMaxCharacters {
+		return fmt.Errorf("Label is longer than the max of %d characters", TxLabelMaxCharacters)
+	}
+	return n.Datastore.TxMetadata().PutLabel(txid, label)
+}
@@ -37,6 +37,8 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleOrder
 	case pb.Message_ORDER_CONFIRMATION:
 		return service.handleOrderConfirmation
+	case pb.Message_ORDER_ADJUSTMENT:
+		return service.handleOrderAdjustment
 	case pb.Message_ORDER_CANCEL:
 		return service.handleOrderCancel
 	case pb.Message_ORDER_REJECT:
@@ -53,6 +55,8 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleDisputeUpdate
 	case pb.Message_DISPUTE_CLOSE:
 		return service.handleDisputeClose
+	case pb.Message_DISPUTE_ENDORSEMENT:
+		return service.handleDisputeEndorsement
 	case pb.Message_CHAT:
 		return service.handleChat
 	case pb.Message_MODERATOR_ADD:
@@ -211,6 +215,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			return errorResponse("Error building order confirmation"), nil
 		}
 		service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		service.node.ProtectPeer(peer.Pretty(), core.OrderProtectTag(contract.VendorOrderConfirmation.OrderID))
 		m := pb.Message{
 			MessageType: pb.Message_ORDER_CONFIRMATION,
 			Payload:     a,
@@ -239,6 +244,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			return errorResponse(err.Error()), err
 		}
 		service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		service.node.ProtectPeer(peer.Pretty(), core.OrderProtectTag(orderId))
 		return nil, nil
 	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED && !offline {
 		total, err := service.node.CalculateOrderTotal(contract)
@@ -277,6 +283,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			return errorResponse("Error building order confirmation"), nil
 		}
 		service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		service.node.ProtectPeer(peer.Pretty(), core.OrderProtectTag(contract.VendorOrderConfirmation.OrderID))
 		m := pb.Message{
 			MessageType: pb.Message_ORDER_CONFIRMATION,
 			Payload:     a,
@@ -305,6 +312,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			return errorResponse(err.Error()), err
 		}
 		service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_PAYMENT, false)
+		service.node.ProtectPeer(peer.Pretty(), core.OrderProtectTag(orderId))
 		return nil, nil
 	}
 	log.Error("Unrecognized payment type")
@@ -360,6 +368,58 @@ func (service *OpenBazaarService) handleOrderConfirmation(p peer.ID, pmes *pb.Me
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handleOrderAdjustment(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received ORDER_ADJUSTMENT message from %s", p.Pretty())
+
+	// Unmarshal payload
+	vendorContract := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, vendorContract)
+	if err != nil {
+		return nil, fmt.Errorf("Could not unmarshal ORDER_ADJUSTMENT from %s", p.Pretty())
+	}
+	if len(vendorContract.VendorOrderAdjustments) == 0 {
+		return nil, errors.New("ORDER_ADJUSTMENT message does not contain an adjustment")
+	}
+	adjustment := vendorContract.VendorOrderAdjustments[len(vendorContract.VendorOrderAdjustments)-1]
+	orderId := adjustment.OrderID
+
+	// Load the order
+	contract, state, funded, _, _, err := service.datastore.Purchases().GetByOrderId(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Payment has already been sent so the total can't change
+	if funded || state != pb.OrderState_AWAITING_PAYMENT {
+		return nil, core.ErrOrderAlreadyFunded
+	}
+
+	// Validate the adjustment
+	err = service.node.ValidateOrderAdjustment(vendorContract, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// Append the adjustment along with its signature, which is the last adjustment
+	// signature on the vendor's contract. We already have the earlier ones.
+	var adjustmentSig *pb.Signature
+	for _, sig := range vendorContract.Signatures {
+		if sig.Section == pb.Signature_ORDER_ADJUSTMENT {
+			adjustmentSig = sig
+		}
+	}
+	contract.VendorOrderAdjustments = append(contract.VendorOrderAdjustments, adjustment)
+	contract.Signatures = append(contract.Signatures, adjustmentSig)
+	service.datastore.Purchases().Put(orderId, *contract, state, false)
+
+	// Send notification to websocket
+	n := notifications.OrderAdjustmentNotification{orderId, adjustment.NewAmount, adjustment.Reason}
+	service.broadcast <- n
+	service.datastore.Notifications().Put(notifications.Wrap(n), time.Now())
+
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleOrderCancel(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 	log.Debugf("Received ORDER_CANCEL message from %s", p.Pretty())
 
@@ -373,6 +433,10 @@ func (service *OpenBazaarService) handleOrderCancel(p peer.ID, pmes *pb.Message,
 
 	// Set message state to canceled
 	service.datastore.Sales().Put(orderId, *contract, pb.OrderState_CANCELED, false)
+	service.node.UnprotectPeer(p.Pretty(), core.OrderProtectTag(orderId))
+	if err := service.node.ExcludeResponseTime(orderId); err != nil {
+		return nil, err
+	}
 
 	return nil, nil
 }
@@ -512,7 +576,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			sig := spvwallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = service.node.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
+		_, err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
 		if err != nil {
 			return nil, err
 		}
@@ -520,6 +584,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 
 	// Set message state to rejected
 	service.datastore.Purchases().Put(rejectMsg.OrderID, *contract, pb.OrderState_DECLINED, false)
+	service.node.UnprotectPeer(p.Pretty(), core.OrderProtectTag(rejectMsg.OrderID))
 
 	// Send notification to websocket
 	n := notifications.OrderCancelNotification{rejectMsg.OrderID}
@@ -614,7 +679,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			sig := spvwallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = service.node.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
+		_, err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
 		if err != nil {
 			return nil, err
 		}
@@ -628,6 +693,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 
 	// Set message state to refunded
 	service.datastore.Purchases().Put(contract.Refund.OrderID, *contract, pb.OrderState_REFUNDED, false)
+	service.node.UnprotectPeer(p.Pretty(), core.OrderProtectTag(contract.Refund.OrderID))
 
 	// Send notification to websocket
 	n := notifications.RefundNotification{contract.Refund.OrderID}
@@ -746,7 +812,7 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 			buyerSignatures = append(buyerSignatures, sig)
 		}
 
-		_, err = service.node.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
+		_, err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
 		if err != nil {
 			return nil, err
 		}
@@ -759,6 +825,7 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 
 	// Set message state to complete
 	service.datastore.Sales().Put(rc.BuyerOrderCompletion.OrderId, *contract, pb.OrderState_COMPLETED, false)
+	service.node.UnprotectPeer(p.Pretty(), core.OrderProtectTag(rc.BuyerOrderCompletion.OrderId))
 
 	// Send notification to websocket
 	n := notifications.CompletionNotification{rc.BuyerOrderCompletion.OrderId}
@@ -850,11 +917,15 @@ func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message
 	// Load the order
 	isPurchase := false
 	var contract *pb.RicardianContract
+	if rc.DisputeResolution == nil {
+		return nil, errors.New("Dispute resolution message is nil")
+	}
 	contract, _, _, _, _, err = service.datastore.Sales().GetByOrderId(rc.DisputeResolution.OrderId)
 	if err != nil {
 		contract, _, _, _, _, err = service.datastore.Purchases().GetByOrderId(rc.DisputeResolution.OrderId)
 		if err != nil {
-			return nil, err
+			// We may be a co-moderator on the order's panel
+			return nil, service.node.ProcessDisputeProposal(rc)
 		}
 		isPurchase = true
 	}
@@ -878,15 +949,23 @@ func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message
 			contract.Signatures = append(contract.Signatures, sig)
 		}
 	}
+	// A panel's resolution is only decided once enough co-moderators endorse it
+	state := pb.OrderState_DECIDED
+	if !core.PanelApproved(contract) {
+		state = pb.OrderState_DISPUTED
+	}
 	if isPurchase {
 		// Set message state to complete
-		err = service.datastore.Purchases().Put(rc.DisputeResolution.OrderId, *contract, pb.OrderState_DECIDED, false)
+		err = service.datastore.Purchases().Put(rc.DisputeResolution.OrderId, *contract, state, false)
 	} else {
-		err = service.datastore.Sales().Put(rc.DisputeResolution.OrderId, *contract, pb.OrderState_DECIDED, false)
+		err = service.datastore.Sales().Put(rc.DisputeResolution.OrderId, *contract, state, false)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if state != pb.OrderState_DECIDED {
+		return nil, nil
+	}
 
 	// Send notification to websocket
 	n := notifications.DisputeCloseNotification{rc.DisputeResolution.OrderId}
@@ -895,6 +974,23 @@ func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handleDisputeEndorsement(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received DISPUTE_ENDORSEMENT message from %s", p.Pretty())
+
+	// Unmarshall
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.node.ProcessDisputeEndorsement(rc)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleChat(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 	log.Debugf("Received CHAT message from %s", p.Pretty())
 
@@ -18,6 +18,7 @@ import (
 	"strconv"
 
 	"bufio"
+	"crypto/hmac"
 	"crypto/rand"
 	"io/ioutil"
 	"net/http"
@@ -421,6 +422,11 @@ func (x *Start) Execute(args []string) error {
 	}
 	ipfslogging.Output(w2)()
 
+	// A broken multiaddr transcoder would make us publish addresses nobody can parse
+	for _, err := range ma.VerifyProtocolTable() {
+		log.Warning("Multiaddr protocol table:", err)
+	}
+
 	// If the database cannot be decrypted, exit
 	if sqliteDB.Config().IsEncrypted() {
 		sqliteDB.Close()
@@ -438,6 +444,12 @@ func (x *Start) Execute(args []string) error {
 		}
 	}
 
+	// Bring the database schema of an older repo up to date
+	if err := sqliteDB.Migrate(); err != nil {
+		log.Error(err)
+		return err
+	}
+
 	// Create authentication cookie
 	var authCookie http.Cookie
 	authCookie.Name = "OpenBazaar_Auth_Cookie"
@@ -498,6 +510,21 @@ func (x *Start) Execute(args []string) error {
 	}
 	cfg.Identity = identity
 
+	// Encrypt PII in order records with a key derived from the identity key
+	encryptOrders, err := repo.GetEncryptOrderData(path.Join(repoPath, "config"))
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if encryptOrders {
+		mac := hmac.New(sha256.New, identityKey)
+		mac.Write([]byte("OpenBazaar order data"))
+		if err := sqliteDB.EnableOrderEncryption(mac.Sum(nil)); err != nil {
+			log.Error(err)
+			return err
+		}
+	}
+
 	onionAddr, err := obnet.MaybeCreateHiddenServiceKey(repoPath)
 	if err != nil {
 		log.Error(err)
@@ -825,6 +852,20 @@ func (x *Start) Execute(args []string) error {
 		return err
 	}
 
+	// Unpaid order expiry
+	unpaidOrderExpiry, err := repo.GetUnpaidOrderExpiry(path.Join(repoPath, "config"))
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	// Batched order settlement
+	settlementConfig, err := repo.GetSettlementConfig(path.Join(repoPath, "config"))
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
 	var exchangeRates bitcoin.ExchangeRates
 	if !x.DisableExchangeRates {
 		exchangeRates = exchange.NewBitcoinPriceFetcher(torDialer)
@@ -888,7 +929,12 @@ func (x *Start) Execute(args []string) error {
 		core.Node.PointerRepublisher = PR
 		if !x.DisableWallet {
 			MR.Wait()
-			TL := lis.NewTransactionListener(core.Node.Datastore, core.Node.Broadcast, core.Node.Wallet)
+			TL := lis.NewTransactionListener(core.Node.Datastore, core.Node.Broadcast, core.Node.Wallet, func(slug string) error {
+				if err := core.Node.UpdateIndexInventory(slug); err != nil {
+					return err
+				}
+				return core.Node.SeedNode()
+			})
 			WL := lis.NewWalletListener(core.Node.Datastore, core.Node.Broadcast)
 			wallet.AddTransactionListener(TL.OnTransactionReceived)
 			wallet.AddTransactionListener(WL.OnTransactionReceived)
@@ -896,6 +942,15 @@ func (x *Start) Execute(args []string) error {
 			su := bitcoin.NewStatusUpdater(wallet, core.Node.Broadcast, nd.Context())
 			go su.Start()
 			go wallet.Start()
+			OE := core.NewOrderExpirer(core.Node.Datastore, core.Node.Broadcast, unpaidOrderExpiry)
+			go OE.Run()
+			if _, ok := core.Node.Wallet.(bitcoin.BatchWallet); ok {
+				ST := core.NewSettler(core.Node.Datastore, core.Node.Wallet, core.Node.Broadcast, settlementConfig.Threshold, settlementConfig.MaxAge())
+				go ST.Run()
+			}
+		}
+		if err := core.Node.ProtectConnections(); err != nil {
+			log.Error(err)
 		}
 		core.Node.UpdateFollow()
 		core.Node.SeedNode()
@@ -66,6 +66,34 @@ func (Listing_Metadata_Format) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor1, []int{1, 0, 1}
 }
 
+// Controls how much of the inventory is shown publicly. The node
+// always tracks the exact quantity internally.
+type Listing_Item_QuantityDisplay int32
+
+const (
+	Listing_Item_HIDDEN  Listing_Item_QuantityDisplay = 0
+	Listing_Item_EXACT   Listing_Item_QuantityDisplay = 1
+	Listing_Item_BOOLEAN Listing_Item_QuantityDisplay = 2
+)
+
+var Listing_Item_QuantityDisplay_name = map[int32]string{
+	0: "HIDDEN",
+	1: "EXACT",
+	2: "BOOLEAN",
+}
+var Listing_Item_QuantityDisplay_value = map[string]int32{
+	"HIDDEN":  0,
+	"EXACT":   1,
+	"BOOLEAN": 2,
+}
+
+func (x Listing_Item_QuantityDisplay) String() string {
+	return proto.EnumName(Listing_Item_QuantityDisplay_name, int32(x))
+}
+func (Listing_Item_QuantityDisplay) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{1, 1, 0}
+}
+
 type Listing_ShippingOption_ShippingType int32
 
 const (
@@ -148,14 +176,16 @@ func (Order_Payment_Method) EnumDescriptor() ([]byte, []int) { return fileDescri
 type Signature_Section int32
 
 const (
-	Signature_LISTING            Signature_Section = 0
-	Signature_ORDER              Signature_Section = 1
-	Signature_ORDER_CONFIRMATION Signature_Section = 2
-	Signature_ORDER_FULFILLMENT  Signature_Section = 3
-	Signature_ORDER_COMPLETION   Signature_Section = 4
-	Signature_DISPUTE            Signature_Section = 5
-	Signature_DISPUTE_RESOLUTION Signature_Section = 6
-	Signature_REFUND             Signature_Section = 7
+	Signature_LISTING             Signature_Section = 0
+	Signature_ORDER               Signature_Section = 1
+	Signature_ORDER_CONFIRMATION  Signature_Section = 2
+	Signature_ORDER_FULFILLMENT   Signature_Section = 3
+	Signature_ORDER_COMPLETION    Signature_Section = 4
+	Signature_DISPUTE             Signature_Section = 5
+	Signature_DISPUTE_RESOLUTION  Signature_Section = 6
+	Signature_REFUND              Signature_Section = 7
+	Signature_ORDER_ADJUSTMENT    Signature_Section = 8
+	Signature_DISPUTE_ENDORSEMENT Signature_Section = 9
 )
 
 var Signature_Section_name = map[int32]string{
@@ -167,33 +197,39 @@ var Signature_Section_name = map[int32]string{
 	5: "DISPUTE",
 	6: "DISPUTE_RESOLUTION",
 	7: "REFUND",
+	8: "ORDER_ADJUSTMENT",
+	9: "DISPUTE_ENDORSEMENT",
 }
 var Signature_Section_value = map[string]int32{
-	"LISTING":            0,
-	"ORDER":              1,
-	"ORDER_CONFIRMATION": 2,
-	"ORDER_FULFILLMENT":  3,
-	"ORDER_COMPLETION":   4,
-	"DISPUTE":            5,
-	"DISPUTE_RESOLUTION": 6,
-	"REFUND":             7,
+	"LISTING":             0,
+	"ORDER":               1,
+	"ORDER_CONFIRMATION":  2,
+	"ORDER_FULFILLMENT":   3,
+	"ORDER_COMPLETION":    4,
+	"DISPUTE":             5,
+	"DISPUTE_RESOLUTION":  6,
+	"REFUND":              7,
+	"ORDER_ADJUSTMENT":    8,
+	"DISPUTE_ENDORSEMENT": 9,
 }
 
 func (x Signature_Section) String() string {
 	return proto.EnumName(Signature_Section_name, int32(x))
 }
-func (Signature_Section) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{15, 0} }
+func (Signature_Section) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{19, 0} }
 
 type RicardianContract struct {
-	VendorListings          []*Listing          `protobuf:"bytes,1,rep,name=vendorListings" json:"vendorListings,omitempty"`
-	BuyerOrder              *Order              `protobuf:"bytes,2,opt,name=buyerOrder" json:"buyerOrder,omitempty"`
-	VendorOrderConfirmation *OrderConfirmation  `protobuf:"bytes,3,opt,name=vendorOrderConfirmation" json:"vendorOrderConfirmation,omitempty"`
-	VendorOrderFulfillment  []*OrderFulfillment `protobuf:"bytes,4,rep,name=vendorOrderFulfillment" json:"vendorOrderFulfillment,omitempty"`
-	BuyerOrderCompletion    *OrderCompletion    `protobuf:"bytes,5,opt,name=buyerOrderCompletion" json:"buyerOrderCompletion,omitempty"`
-	Dispute                 *Dispute            `protobuf:"bytes,6,opt,name=dispute" json:"dispute,omitempty"`
-	DisputeResolution       *DisputeResolution  `protobuf:"bytes,7,opt,name=disputeResolution" json:"disputeResolution,omitempty"`
-	Refund                  *Refund             `protobuf:"bytes,8,opt,name=refund" json:"refund,omitempty"`
-	Signatures              []*Signature        `protobuf:"bytes,9,rep,name=signatures" json:"signatures,omitempty"`
+	VendorListings          []*Listing            `protobuf:"bytes,1,rep,name=vendorListings" json:"vendorListings,omitempty"`
+	BuyerOrder              *Order                `protobuf:"bytes,2,opt,name=buyerOrder" json:"buyerOrder,omitempty"`
+	VendorOrderConfirmation *OrderConfirmation    `protobuf:"bytes,3,opt,name=vendorOrderConfirmation" json:"vendorOrderConfirmation,omitempty"`
+	VendorOrderFulfillment  []*OrderFulfillment   `protobuf:"bytes,4,rep,name=vendorOrderFulfillment" json:"vendorOrderFulfillment,omitempty"`
+	BuyerOrderCompletion    *OrderCompletion      `protobuf:"bytes,5,opt,name=buyerOrderCompletion" json:"buyerOrderCompletion,omitempty"`
+	Dispute                 *Dispute              `protobuf:"bytes,6,opt,name=dispute" json:"dispute,omitempty"`
+	DisputeResolution       *DisputeResolution    `protobuf:"bytes,7,opt,name=disputeResolution" json:"disputeResolution,omitempty"`
+	Refund                  *Refund               `protobuf:"bytes,8,opt,name=refund" json:"refund,omitempty"`
+	Signatures              []*Signature          `protobuf:"bytes,9,rep,name=signatures" json:"signatures,omitempty"`
+	VendorOrderAdjustments  []*OrderAdjustment    `protobuf:"bytes,10,rep,name=vendorOrderAdjustments" json:"vendorOrderAdjustments,omitempty"`
+	DisputeEndorsements     []*DisputeEndorsement `protobuf:"bytes,11,rep,name=disputeEndorsements" json:"disputeEndorsements,omitempty"`
 }
 
 func (m *RicardianContract) Reset()                    { *m = RicardianContract{} }
@@ -264,17 +300,32 @@ func (m *RicardianContract) GetSignatures() []*Signature {
 	return nil
 }
 
+func (m *RicardianContract) GetVendorOrderAdjustments() []*OrderAdjustment {
+	if m != nil {
+		return m.VendorOrderAdjustments
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetDisputeEndorsements() []*DisputeEndorsement {
+	if m != nil {
+		return m.DisputeEndorsements
+	}
+	return nil
+}
+
 type Listing struct {
-	Slug               string                    `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
-	VendorID           *ID                       `protobuf:"bytes,2,opt,name=vendorID" json:"vendorID,omitempty"`
-	Metadata           *Listing_Metadata         `protobuf:"bytes,3,opt,name=metadata" json:"metadata,omitempty"`
-	Item               *Listing_Item             `protobuf:"bytes,4,opt,name=item" json:"item,omitempty"`
-	ShippingOptions    []*Listing_ShippingOption `protobuf:"bytes,5,rep,name=shippingOptions" json:"shippingOptions,omitempty"`
-	Taxes              []*Listing_Tax            `protobuf:"bytes,6,rep,name=taxes" json:"taxes,omitempty"`
-	Coupons            []*Listing_Coupon         `protobuf:"bytes,7,rep,name=coupons" json:"coupons,omitempty"`
-	Moderators         []string                  `protobuf:"bytes,8,rep,name=moderators" json:"moderators,omitempty"`
-	TermsAndConditions string                    `protobuf:"bytes,9,opt,name=termsAndConditions" json:"termsAndConditions,omitempty"`
-	RefundPolicy       string                    `protobuf:"bytes,10,opt,name=refundPolicy" json:"refundPolicy,omitempty"`
+	Slug               string                      `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
+	VendorID           *ID                         `protobuf:"bytes,2,opt,name=vendorID" json:"vendorID,omitempty"`
+	Metadata           *Listing_Metadata           `protobuf:"bytes,3,opt,name=metadata" json:"metadata,omitempty"`
+	Item               *Listing_Item               `protobuf:"bytes,4,opt,name=item" json:"item,omitempty"`
+	ShippingOptions    []*Listing_ShippingOption   `protobuf:"bytes,5,rep,name=shippingOptions" json:"shippingOptions,omitempty"`
+	Taxes              []*Listing_Tax              `protobuf:"bytes,6,rep,name=taxes" json:"taxes,omitempty"`
+	Coupons            []*Listing_Coupon           `protobuf:"bytes,7,rep,name=coupons" json:"coupons,omitempty"`
+	Moderators         []string                    `protobuf:"bytes,8,rep,name=moderators" json:"moderators,omitempty"`
+	TermsAndConditions string                      `protobuf:"bytes,9,opt,name=termsAndConditions" json:"termsAndConditions,omitempty"`
+	RefundPolicy       string                      `protobuf:"bytes,10,opt,name=refundPolicy" json:"refundPolicy,omitempty"`
+	ReturnInstructions *Listing_ReturnInstructions `protobuf:"bytes,11,opt,name=returnInstructions" json:"returnInstructions,omitempty"`
 }
 
 func (m *Listing) Reset()                    { *m = Listing{} }
@@ -352,6 +403,13 @@ func (m *Listing) GetRefundPolicy() string {
 	return ""
 }
 
+func (m *Listing) GetReturnInstructions() *Listing_ReturnInstructions {
+	if m != nil {
+		return m.ReturnInstructions
+	}
+	return nil
+}
+
 type Listing_Metadata struct {
 	Version          uint32                        `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
 	ContractType     Listing_Metadata_ContractType `protobuf:"varint,2,opt,name=contractType,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
@@ -417,18 +475,19 @@ func (m *Listing_Metadata) GetLanguage() string {
 }
 
 type Listing_Item struct {
-	Title          string                 `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	ProcessingTime string                 `protobuf:"bytes,3,opt,name=processingTime" json:"processingTime,omitempty"`
-	Price          uint64                 `protobuf:"varint,4,opt,name=price" json:"price,omitempty"`
-	Nsfw           bool                   `protobuf:"varint,5,opt,name=nsfw" json:"nsfw,omitempty"`
-	Tags           []string               `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
-	Images         []*Listing_Item_Image  `protobuf:"bytes,7,rep,name=images" json:"images,omitempty"`
-	Categories     []string               `protobuf:"bytes,8,rep,name=categories" json:"categories,omitempty"`
-	Grams          float32                `protobuf:"fixed32,9,opt,name=grams" json:"grams,omitempty"`
-	Condition      string                 `protobuf:"bytes,10,opt,name=condition" json:"condition,omitempty"`
-	Options        []*Listing_Item_Option `protobuf:"bytes,11,rep,name=options" json:"options,omitempty"`
-	Skus           []*Listing_Item_Sku    `protobuf:"bytes,12,rep,name=skus" json:"skus,omitempty"`
+	Title           string                       `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Description     string                       `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	ProcessingTime  string                       `protobuf:"bytes,3,opt,name=processingTime" json:"processingTime,omitempty"`
+	Price           uint64                       `protobuf:"varint,4,opt,name=price" json:"price,omitempty"`
+	Nsfw            bool                         `protobuf:"varint,5,opt,name=nsfw" json:"nsfw,omitempty"`
+	Tags            []string                     `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+	Images          []*Listing_Item_Image        `protobuf:"bytes,7,rep,name=images" json:"images,omitempty"`
+	Categories      []string                     `protobuf:"bytes,8,rep,name=categories" json:"categories,omitempty"`
+	Grams           float32                      `protobuf:"fixed32,9,opt,name=grams" json:"grams,omitempty"`
+	Condition       string                       `protobuf:"bytes,10,opt,name=condition" json:"condition,omitempty"`
+	Options         []*Listing_Item_Option       `protobuf:"bytes,11,rep,name=options" json:"options,omitempty"`
+	Skus            []*Listing_Item_Sku          `protobuf:"bytes,12,rep,name=skus" json:"skus,omitempty"`
+	QuantityDisplay Listing_Item_QuantityDisplay `protobuf:"varint,13,opt,name=quantityDisplay,enum=Listing_Item_QuantityDisplay" json:"quantityDisplay,omitempty"`
 }
 
 func (m *Listing_Item) Reset()                    { *m = Listing_Item{} }
@@ -520,6 +579,13 @@ func (m *Listing_Item) GetSkus() []*Listing_Item_Sku {
 	return nil
 }
 
+func (m *Listing_Item) GetQuantityDisplay() Listing_Item_QuantityDisplay {
+	if m != nil {
+		return m.QuantityDisplay
+	}
+	return Listing_Item_HIDDEN
+}
+
 type Listing_Item_Option struct {
 	Name        string                         `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Description string                         `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
@@ -791,8 +857,10 @@ type Listing_ShippingOption_ShippingRules_Rule struct {
 func (m *Listing_ShippingOption_ShippingRules_Rule) Reset() {
 	*m = Listing_ShippingOption_ShippingRules_Rule{}
 }
-func (m *Listing_ShippingOption_ShippingRules_Rule) String() string { return proto.CompactTextString(m) }
-func (*Listing_ShippingOption_ShippingRules_Rule) ProtoMessage()    {}
+func (m *Listing_ShippingOption_ShippingRules_Rule) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Listing_ShippingOption_ShippingRules_Rule) ProtoMessage() {}
 func (*Listing_ShippingOption_ShippingRules_Rule) Descriptor() ([]byte, []int) {
 	return fileDescriptor1, []int{1, 2, 1, 0}
 }
@@ -875,12 +943,8 @@ func (m *Listing_Coupon) String() string            { return proto.CompactTextSt
 func (*Listing_Coupon) ProtoMessage()               {}
 func (*Listing_Coupon) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 4} }
 
-type isListing_Coupon_Code interface {
-	isListing_Coupon_Code()
-}
-type isListing_Coupon_Discount interface {
-	isListing_Coupon_Discount()
-}
+type isListing_Coupon_Code interface{ isListing_Coupon_Code() }
+type isListing_Coupon_Discount interface{ isListing_Coupon_Discount() }
 
 type Listing_Coupon_Hash struct {
 	Hash string `protobuf:"bytes,2,opt,name=hash,oneof"`
@@ -1054,6 +1118,39 @@ func _Listing_Coupon_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// Optional. Shown to the buyer and moderator if the order is disputed.
+type Listing_ReturnInstructions struct {
+	Address       string  `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	RestockingFee float32 `protobuf:"fixed32,2,opt,name=restockingFee" json:"restockingFee,omitempty"`
+	Conditions    string  `protobuf:"bytes,3,opt,name=conditions" json:"conditions,omitempty"`
+}
+
+func (m *Listing_ReturnInstructions) Reset()                    { *m = Listing_ReturnInstructions{} }
+func (m *Listing_ReturnInstructions) String() string            { return proto.CompactTextString(m) }
+func (*Listing_ReturnInstructions) ProtoMessage()               {}
+func (*Listing_ReturnInstructions) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 5} }
+
+func (m *Listing_ReturnInstructions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Listing_ReturnInstructions) GetRestockingFee() float32 {
+	if m != nil {
+		return m.RestockingFee
+	}
+	return 0
+}
+
+func (m *Listing_ReturnInstructions) GetConditions() string {
+	if m != nil {
+		return m.Conditions
+	}
+	return ""
+}
+
 type Order struct {
 	RefundAddress        string                     `protobuf:"bytes,1,opt,name=refundAddress" json:"refundAddress,omitempty"`
 	RefundFee            uint64                     `protobuf:"varint,2,opt,name=refundFee" json:"refundFee,omitempty"`
@@ -1283,10 +1380,12 @@ type Order_Item_ShippingOption struct {
 	Service string `protobuf:"bytes,2,opt,name=service" json:"service,omitempty"`
 }
 
-func (m *Order_Item_ShippingOption) Reset()                    { *m = Order_Item_ShippingOption{} }
-func (m *Order_Item_ShippingOption) String() string            { return proto.CompactTextString(m) }
-func (*Order_Item_ShippingOption) ProtoMessage()               {}
-func (*Order_Item_ShippingOption) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2, 1, 1} }
+func (m *Order_Item_ShippingOption) Reset()         { *m = Order_Item_ShippingOption{} }
+func (m *Order_Item_ShippingOption) String() string { return proto.CompactTextString(m) }
+func (*Order_Item_ShippingOption) ProtoMessage()    {}
+func (*Order_Item_ShippingOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{2, 1, 1}
+}
 
 func (m *Order_Item_ShippingOption) GetName() string {
 	if m != nil {
@@ -1309,6 +1408,10 @@ type Order_Payment struct {
 	Chaincode    string               `protobuf:"bytes,4,opt,name=chaincode" json:"chaincode,omitempty"`
 	Address      string               `protobuf:"bytes,5,opt,name=address" json:"address,omitempty"`
 	RedeemScript string               `protobuf:"bytes,6,opt,name=redeemScript" json:"redeemScript,omitempty"`
+	// Set when the order is moderated by a panel. The moderator field holds the
+	// lead moderator, who is also the first member of the panel.
+	ModeratorPanel     []string `protobuf:"bytes,7,rep,name=moderatorPanel" json:"moderatorPanel,omitempty"`
+	ModeratorThreshold uint32   `protobuf:"varint,8,opt,name=moderatorThreshold" json:"moderatorThreshold,omitempty"`
 }
 
 func (m *Order_Payment) Reset()                    { *m = Order_Payment{} }
@@ -1358,6 +1461,20 @@ func (m *Order_Payment) GetRedeemScript() string {
 	return ""
 }
 
+func (m *Order_Payment) GetModeratorPanel() []string {
+	if m != nil {
+		return m.ModeratorPanel
+	}
+	return nil
+}
+
+func (m *Order_Payment) GetModeratorThreshold() uint32 {
+	if m != nil {
+		return m.ModeratorThreshold
+	}
+	return 0
+}
+
 type OrderConfirmation struct {
 	OrderID   string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1365,6 +1482,10 @@ type OrderConfirmation struct {
 	PaymentAddress   string             `protobuf:"bytes,3,opt,name=paymentAddress" json:"paymentAddress,omitempty"`
 	RequestedAmount  uint64             `protobuf:"varint,4,opt,name=requestedAmount" json:"requestedAmount,omitempty"`
 	RatingSignatures []*RatingSignature `protobuf:"bytes,5,rep,name=ratingSignatures" json:"ratingSignatures,omitempty"`
+	// Set when the payment address was freshly derived for this order
+	PaymentAddressDerivation *AddressDerivation `protobuf:"bytes,6,opt,name=paymentAddressDerivation" json:"paymentAddressDerivation,omitempty"`
+	// What each ordered item looked like when the order was confirmed
+	Items []*ItemSnapshot `protobuf:"bytes,7,rep,name=items" json:"items,omitempty"`
 }
 
 func (m *OrderConfirmation) Reset()                    { *m = OrderConfirmation{} }
@@ -1407,6 +1528,166 @@ func (m *OrderConfirmation) GetRatingSignatures() []*RatingSignature {
 	return nil
 }
 
+func (m *OrderConfirmation) GetPaymentAddressDerivation() *AddressDerivation {
+	if m != nil {
+		return m.PaymentAddressDerivation
+	}
+	return nil
+}
+
+func (m *OrderConfirmation) GetItems() []*ItemSnapshot {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type AddressDerivation struct {
+	Index uint32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+}
+
+func (m *AddressDerivation) Reset()                    { *m = AddressDerivation{} }
+func (m *AddressDerivation) String() string            { return proto.CompactTextString(m) }
+func (*AddressDerivation) ProtoMessage()               {}
+func (*AddressDerivation) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+
+func (m *AddressDerivation) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// A preview of an ordered item taken from the listing it was bought from
+type ItemSnapshot struct {
+	ListingHash     string `protobuf:"bytes,1,opt,name=listingHash" json:"listingHash,omitempty"`
+	Slug            string `protobuf:"bytes,2,opt,name=slug" json:"slug,omitempty"`
+	Title           string `protobuf:"bytes,3,opt,name=title" json:"title,omitempty"`
+	Thumbnail       string `protobuf:"bytes,4,opt,name=thumbnail" json:"thumbnail,omitempty"`
+	PricingCurrency string `protobuf:"bytes,5,opt,name=pricingCurrency" json:"pricingCurrency,omitempty"`
+	// Listing price plus the surcharge of the selected variant, in the pricing currency
+	UnitPrice int64                `protobuf:"varint,6,opt,name=unitPrice" json:"unitPrice,omitempty"`
+	Quantity  uint32               `protobuf:"varint,7,opt,name=quantity" json:"quantity,omitempty"`
+	Options   []*Order_Item_Option `protobuf:"bytes,8,rep,name=options" json:"options,omitempty"`
+}
+
+func (m *ItemSnapshot) Reset()                    { *m = ItemSnapshot{} }
+func (m *ItemSnapshot) String() string            { return proto.CompactTextString(m) }
+func (*ItemSnapshot) ProtoMessage()               {}
+func (*ItemSnapshot) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+
+func (m *ItemSnapshot) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *ItemSnapshot) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *ItemSnapshot) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ItemSnapshot) GetThumbnail() string {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return ""
+}
+
+func (m *ItemSnapshot) GetPricingCurrency() string {
+	if m != nil {
+		return m.PricingCurrency
+	}
+	return ""
+}
+
+func (m *ItemSnapshot) GetUnitPrice() int64 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
+}
+
+func (m *ItemSnapshot) GetQuantity() uint32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *ItemSnapshot) GetOptions() []*Order_Item_Option {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type OrderAdjustment struct {
+	OrderID        string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
+	Timestamp      *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	PreviousAmount uint64                     `protobuf:"varint,3,opt,name=previousAmount" json:"previousAmount,omitempty"`
+	NewAmount      uint64                     `protobuf:"varint,4,opt,name=newAmount" json:"newAmount,omitempty"`
+	PaymentAddress string                     `protobuf:"bytes,5,opt,name=paymentAddress" json:"paymentAddress,omitempty"`
+	Reason         string                     `protobuf:"bytes,6,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *OrderAdjustment) Reset()                    { *m = OrderAdjustment{} }
+func (m *OrderAdjustment) String() string            { return proto.CompactTextString(m) }
+func (*OrderAdjustment) ProtoMessage()               {}
+func (*OrderAdjustment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+
+func (m *OrderAdjustment) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *OrderAdjustment) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *OrderAdjustment) GetPreviousAmount() uint64 {
+	if m != nil {
+		return m.PreviousAmount
+	}
+	return 0
+}
+
+func (m *OrderAdjustment) GetNewAmount() uint64 {
+	if m != nil {
+		return m.NewAmount
+	}
+	return 0
+}
+
+func (m *OrderAdjustment) GetPaymentAddress() string {
+	if m != nil {
+		return m.PaymentAddress
+	}
+	return ""
+}
+
+func (m *OrderAdjustment) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type OrderReject struct {
 	OrderID   string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1416,7 +1697,7 @@ type OrderReject struct {
 func (m *OrderReject) Reset()                    { *m = OrderReject{} }
 func (m *OrderReject) String() string            { return proto.CompactTextString(m) }
 func (*OrderReject) ProtoMessage()               {}
-func (*OrderReject) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+func (*OrderReject) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
 
 func (m *OrderReject) GetOrderID() string {
 	if m != nil {
@@ -1447,7 +1728,7 @@ type RatingSignature struct {
 func (m *RatingSignature) Reset()                    { *m = RatingSignature{} }
 func (m *RatingSignature) String() string            { return proto.CompactTextString(m) }
 func (*RatingSignature) ProtoMessage()               {}
-func (*RatingSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (*RatingSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
 
 func (m *RatingSignature) GetMetadata() *RatingSignature_TransactionMetadata {
 	if m != nil {
@@ -1473,7 +1754,7 @@ func (m *RatingSignature_TransactionMetadata) Reset()         { *m = RatingSigna
 func (m *RatingSignature_TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*RatingSignature_TransactionMetadata) ProtoMessage()    {}
 func (*RatingSignature_TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{5, 0}
+	return fileDescriptor1, []int{8, 0}
 }
 
 func (m *RatingSignature_TransactionMetadata) GetListingSlug() string {
@@ -1505,7 +1786,7 @@ type BitcoinSignature struct {
 func (m *BitcoinSignature) Reset()                    { *m = BitcoinSignature{} }
 func (m *BitcoinSignature) String() string            { return proto.CompactTextString(m) }
 func (*BitcoinSignature) ProtoMessage()               {}
-func (*BitcoinSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+func (*BitcoinSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
 
 func (m *BitcoinSignature) GetInputIndex() uint32 {
 	if m != nil {
@@ -1538,7 +1819,7 @@ type OrderFulfillment struct {
 func (m *OrderFulfillment) Reset()                    { *m = OrderFulfillment{} }
 func (m *OrderFulfillment) String() string            { return proto.CompactTextString(m) }
 func (*OrderFulfillment) ProtoMessage()               {}
-func (*OrderFulfillment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+func (*OrderFulfillment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
 
 func (m *OrderFulfillment) GetOrderId() string {
 	if m != nil {
@@ -1605,7 +1886,7 @@ func (m *OrderFulfillment_PhysicalDelivery) Reset()         { *m = OrderFulfillm
 func (m *OrderFulfillment_PhysicalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_PhysicalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_PhysicalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{7, 0}
+	return fileDescriptor1, []int{10, 0}
 }
 
 func (m *OrderFulfillment_PhysicalDelivery) GetShipper() string {
@@ -1631,7 +1912,7 @@ func (m *OrderFulfillment_DigitalDelivery) Reset()         { *m = OrderFulfillme
 func (m *OrderFulfillment_DigitalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_DigitalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_DigitalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{7, 1}
+	return fileDescriptor1, []int{10, 1}
 }
 
 func (m *OrderFulfillment_DigitalDelivery) GetUrl() string {
@@ -1649,15 +1930,16 @@ func (m *OrderFulfillment_DigitalDelivery) GetPassword() string {
 }
 
 type OrderFulfillment_Payout struct {
-	Sigs             []*BitcoinSignature `protobuf:"bytes,1,rep,name=sigs" json:"sigs,omitempty"`
-	PayoutAddress    string              `protobuf:"bytes,2,opt,name=payoutAddress" json:"payoutAddress,omitempty"`
-	PayoutFeePerByte uint64              `protobuf:"varint,3,opt,name=payoutFeePerByte" json:"payoutFeePerByte,omitempty"`
+	Sigs                    []*BitcoinSignature `protobuf:"bytes,1,rep,name=sigs" json:"sigs,omitempty"`
+	PayoutAddress           string              `protobuf:"bytes,2,opt,name=payoutAddress" json:"payoutAddress,omitempty"`
+	PayoutFeePerByte        uint64              `protobuf:"varint,3,opt,name=payoutFeePerByte" json:"payoutFeePerByte,omitempty"`
+	PayoutAddressDerivation *AddressDerivation  `protobuf:"bytes,4,opt,name=payoutAddressDerivation" json:"payoutAddressDerivation,omitempty"`
 }
 
 func (m *OrderFulfillment_Payout) Reset()                    { *m = OrderFulfillment_Payout{} }
 func (m *OrderFulfillment_Payout) String() string            { return proto.CompactTextString(m) }
 func (*OrderFulfillment_Payout) ProtoMessage()               {}
-func (*OrderFulfillment_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7, 2} }
+func (*OrderFulfillment_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10, 2} }
 
 func (m *OrderFulfillment_Payout) GetSigs() []*BitcoinSignature {
 	if m != nil {
@@ -1680,6 +1962,13 @@ func (m *OrderFulfillment_Payout) GetPayoutFeePerByte() uint64 {
 	return 0
 }
 
+func (m *OrderFulfillment_Payout) GetPayoutAddressDerivation() *AddressDerivation {
+	if m != nil {
+		return m.PayoutAddressDerivation
+	}
+	return nil
+}
+
 type OrderCompletion struct {
 	OrderId    string                     `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
 	Timestamp  *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1690,7 +1979,7 @@ type OrderCompletion struct {
 func (m *OrderCompletion) Reset()                    { *m = OrderCompletion{} }
 func (m *OrderCompletion) String() string            { return proto.CompactTextString(m) }
 func (*OrderCompletion) ProtoMessage()               {}
-func (*OrderCompletion) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+func (*OrderCompletion) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
 
 func (m *OrderCompletion) GetOrderId() string {
 	if m != nil {
@@ -1728,7 +2017,7 @@ type Rating struct {
 func (m *Rating) Reset()                    { *m = Rating{} }
 func (m *Rating) String() string            { return proto.CompactTextString(m) }
 func (*Rating) ProtoMessage()               {}
-func (*Rating) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+func (*Rating) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
 
 func (m *Rating) GetRatingData() *Rating_RatingData {
 	if m != nil {
@@ -1765,7 +2054,7 @@ type Rating_RatingData struct {
 func (m *Rating_RatingData) Reset()                    { *m = Rating_RatingData{} }
 func (m *Rating_RatingData) String() string            { return proto.CompactTextString(m) }
 func (*Rating_RatingData) ProtoMessage()               {}
-func (*Rating_RatingData) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9, 0} }
+func (*Rating_RatingData) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12, 0} }
 
 func (m *Rating_RatingData) GetRatingKey() []byte {
 	if m != nil {
@@ -1883,7 +2172,7 @@ type Dispute struct {
 func (m *Dispute) Reset()                    { *m = Dispute{} }
 func (m *Dispute) String() string            { return proto.CompactTextString(m) }
 func (*Dispute) ProtoMessage()               {}
-func (*Dispute) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+func (*Dispute) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
 
 func (m *Dispute) GetTimestamp() *google_protobuf.Timestamp {
 	if m != nil {
@@ -1932,7 +2221,7 @@ type DisputeResolution struct {
 func (m *DisputeResolution) Reset()                    { *m = DisputeResolution{} }
 func (m *DisputeResolution) String() string            { return proto.CompactTextString(m) }
 func (*DisputeResolution) ProtoMessage()               {}
-func (*DisputeResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+func (*DisputeResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
 
 func (m *DisputeResolution) GetTimestamp() *google_protobuf.Timestamp {
 	if m != nil {
@@ -1987,7 +2276,7 @@ type DisputeResolution_Payout struct {
 func (m *DisputeResolution_Payout) Reset()                    { *m = DisputeResolution_Payout{} }
 func (m *DisputeResolution_Payout) String() string            { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout) ProtoMessage()               {}
-func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11, 0} }
+func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14, 0} }
 
 func (m *DisputeResolution_Payout) GetSigs() []*BitcoinSignature {
 	if m != nil {
@@ -2033,7 +2322,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{11, 0, 0}
+	return fileDescriptor1, []int{14, 0, 0}
 }
 
 func (m *DisputeResolution_Payout_Output) GetScript() string {
@@ -2050,6 +2339,47 @@ func (m *DisputeResolution_Payout_Output) GetAmount() uint64 {
 	return 0
 }
 
+// A panel moderator's agreement with the lead moderator's dispute resolution
+type DisputeEndorsement struct {
+	OrderId     string                     `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	ModeratorID string                     `protobuf:"bytes,2,opt,name=moderatorID" json:"moderatorID,omitempty"`
+	Sigs        []*BitcoinSignature        `protobuf:"bytes,3,rep,name=sigs" json:"sigs,omitempty"`
+	Timestamp   *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *DisputeEndorsement) Reset()                    { *m = DisputeEndorsement{} }
+func (m *DisputeEndorsement) String() string            { return proto.CompactTextString(m) }
+func (*DisputeEndorsement) ProtoMessage()               {}
+func (*DisputeEndorsement) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+
+func (m *DisputeEndorsement) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *DisputeEndorsement) GetModeratorID() string {
+	if m != nil {
+		return m.ModeratorID
+	}
+	return ""
+}
+
+func (m *DisputeEndorsement) GetSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+func (m *DisputeEndorsement) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type Outpoint struct {
 	Hash  string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
 	Index uint32 `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
@@ -2059,7 +2389,7 @@ type Outpoint struct {
 func (m *Outpoint) Reset()                    { *m = Outpoint{} }
 func (m *Outpoint) String() string            { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()               {}
-func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
 
 func (m *Outpoint) GetHash() string {
 	if m != nil {
@@ -2093,7 +2423,7 @@ type Refund struct {
 func (m *Refund) Reset()                    { *m = Refund{} }
 func (m *Refund) String() string            { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()               {}
-func (*Refund) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+func (*Refund) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17} }
 
 func (m *Refund) GetOrderID() string {
 	if m != nil {
@@ -2138,7 +2468,7 @@ type Refund_TransactionInfo struct {
 func (m *Refund_TransactionInfo) Reset()                    { *m = Refund_TransactionInfo{} }
 func (m *Refund_TransactionInfo) String() string            { return proto.CompactTextString(m) }
 func (*Refund_TransactionInfo) ProtoMessage()               {}
-func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13, 0} }
+func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17, 0} }
 
 func (m *Refund_TransactionInfo) GetTxid() string {
 	if m != nil {
@@ -2164,7 +2494,7 @@ type ID struct {
 func (m *ID) Reset()                    { *m = ID{} }
 func (m *ID) String() string            { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()               {}
-func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18} }
 
 func (m *ID) GetPeerID() string {
 	if m != nil {
@@ -2202,7 +2532,7 @@ type ID_Pubkeys struct {
 func (m *ID_Pubkeys) Reset()                    { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string            { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()               {}
-func (*ID_Pubkeys) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14, 0} }
+func (*ID_Pubkeys) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18, 0} }
 
 func (m *ID_Pubkeys) GetIdentity() []byte {
 	if m != nil {
@@ -2226,7 +2556,7 @@ type Signature struct {
 func (m *Signature) Reset()                    { *m = Signature{} }
 func (m *Signature) String() string            { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()               {}
-func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{19} }
 
 func (m *Signature) GetSection() Signature_Section {
 	if m != nil {
@@ -2251,7 +2581,7 @@ type SignedListing struct {
 func (m *SignedListing) Reset()                    { *m = SignedListing{} }
 func (m *SignedListing) String() string            { return proto.CompactTextString(m) }
 func (*SignedListing) ProtoMessage()               {}
-func (*SignedListing) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
+func (*SignedListing) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{20} }
 
 func (m *SignedListing) GetListing() *Listing {
 	if m != nil {
@@ -2289,6 +2619,7 @@ func init() {
 	proto.RegisterType((*Listing_ShippingOption_ShippingRules_Rule)(nil), "Listing.ShippingOption.ShippingRules.Rule")
 	proto.RegisterType((*Listing_Tax)(nil), "Listing.Tax")
 	proto.RegisterType((*Listing_Coupon)(nil), "Listing.Coupon")
+	proto.RegisterType((*Listing_ReturnInstructions)(nil), "Listing.ReturnInstructions")
 	proto.RegisterType((*Order)(nil), "Order")
 	proto.RegisterType((*Order_Shipping)(nil), "Order.Shipping")
 	proto.RegisterType((*Order_Item)(nil), "Order.Item")
@@ -2296,6 +2627,9 @@ func init() {
 	proto.RegisterType((*Order_Item_ShippingOption)(nil), "Order.Item.ShippingOption")
 	proto.RegisterType((*Order_Payment)(nil), "Order.Payment")
 	proto.RegisterType((*OrderConfirmation)(nil), "OrderConfirmation")
+	proto.RegisterType((*AddressDerivation)(nil), "AddressDerivation")
+	proto.RegisterType((*ItemSnapshot)(nil), "ItemSnapshot")
+	proto.RegisterType((*OrderAdjustment)(nil), "OrderAdjustment")
 	proto.RegisterType((*OrderReject)(nil), "OrderReject")
 	proto.RegisterType((*RatingSignature)(nil), "RatingSignature")
 	proto.RegisterType((*RatingSignature_TransactionMetadata)(nil), "RatingSignature.TransactionMetadata")
@@ -2311,6 +2645,7 @@ func init() {
 	proto.RegisterType((*DisputeResolution)(nil), "DisputeResolution")
 	proto.RegisterType((*DisputeResolution_Payout)(nil), "DisputeResolution.Payout")
 	proto.RegisterType((*DisputeResolution_Payout_Output)(nil), "DisputeResolution.Payout.Output")
+	proto.RegisterType((*DisputeEndorsement)(nil), "DisputeEndorsement")
 	proto.RegisterType((*Outpoint)(nil), "Outpoint")
 	proto.RegisterType((*Refund)(nil), "Refund")
 	proto.RegisterType((*Refund_TransactionInfo)(nil), "Refund.TransactionInfo")
@@ -2320,6 +2655,7 @@ func init() {
 	proto.RegisterType((*SignedListing)(nil), "SignedListing")
 	proto.RegisterEnum("Listing_Metadata_ContractType", Listing_Metadata_ContractType_name, Listing_Metadata_ContractType_value)
 	proto.RegisterEnum("Listing_Metadata_Format", Listing_Metadata_Format_name, Listing_Metadata_Format_value)
+	proto.RegisterEnum("Listing_Item_QuantityDisplay", Listing_Item_QuantityDisplay_name, Listing_Item_QuantityDisplay_value)
 	proto.RegisterEnum("Listing_ShippingOption_ShippingType", Listing_ShippingOption_ShippingType_name, Listing_ShippingOption_ShippingType_value)
 	proto.RegisterEnum("Listing_ShippingOption_ShippingRules_RuleType", Listing_ShippingOption_ShippingRules_RuleType_name, Listing_ShippingOption_ShippingRules_RuleType_value)
 	proto.RegisterEnum("Order_Payment_Method", Order_Payment_Method_name, Order_Payment_Method_value)
@@ -2329,203 +2665,229 @@ func init() {
 func init() { proto.RegisterFile("contracts.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 3162 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x93, 0xdb, 0x48,
-	0x15, 0x8f, 0xfc, 0xed, 0x37, 0x9e, 0xb1, 0xa7, 0x33, 0x49, 0xbc, 0x66, 0xd9, 0x24, 0xae, 0x24,
-	0x64, 0xb3, 0x59, 0x6d, 0x76, 0xb8, 0xa4, 0x58, 0x8a, 0x5d, 0x8f, 0xe5, 0xc9, 0x68, 0x33, 0x99,
-	0xf1, 0xb6, 0x3d, 0xbb, 0x2c, 0x97, 0x29, 0x8d, 0xd4, 0xe3, 0x11, 0x91, 0x25, 0xaf, 0xd4, 0x9a,
-	0x1d, 0x73, 0xa3, 0x8a, 0x03, 0xc5, 0x85, 0x0b, 0x55, 0x7b, 0xe0, 0xaf, 0xa0, 0xe0, 0x04, 0xb7,
-	0x3d, 0x71, 0xe6, 0xc4, 0x0d, 0x8a, 0x33, 0x17, 0xaa, 0xa8, 0xe2, 0xc0, 0x01, 0xaa, 0xbf, 0x64,
-	0x49, 0xf6, 0xe4, 0x03, 0x8a, 0xe2, 0x64, 0xbd, 0xdf, 0x7b, 0xdd, 0xea, 0x7e, 0xfd, 0x3e, 0x5b,
-	0x86, 0xa6, 0x1d, 0xf8, 0x34, 0xb4, 0x6c, 0x1a, 0xe9, 0xb3, 0x30, 0xa0, 0x41, 0x07, 0xd9, 0x41,
-	0xec, 0xd3, 0x70, 0x6e, 0x07, 0x0e, 0x51, 0xd8, 0xcd, 0x49, 0x10, 0x4c, 0x3c, 0xf2, 0x1e, 0xa7,
-	0x4e, 0xe2, 0xd3, 0xf7, 0xa8, 0x3b, 0x25, 0x11, 0xb5, 0xa6, 0x33, 0x21, 0xd0, 0xfd, 0x57, 0x11,
-	0x36, 0xb1, 0x6b, 0x5b, 0xa1, 0xe3, 0x5a, 0x7e, 0x5f, 0xce, 0x88, 0x1e, 0xc1, 0xc6, 0x39, 0xf1,
-	0x9d, 0x20, 0xdc, 0x77, 0x23, 0xea, 0xfa, 0x93, 0xa8, 0xad, 0xdd, 0x2a, 0xde, 0x5f, 0xdb, 0xae,
-	0xe9, 0x12, 0xc0, 0x39, 0x3e, 0xba, 0x07, 0x70, 0x12, 0xcf, 0x49, 0x78, 0x18, 0x3a, 0x24, 0x6c,
-	0x17, 0x6e, 0x69, 0xf7, 0xd7, 0xb6, 0x2b, 0x3a, 0xa7, 0x70, 0x8a, 0x83, 0xf6, 0xe1, 0x86, 0x18,
-	0xc9, 0xc9, 0x7e, 0xe0, 0x9f, 0xba, 0xe1, 0xd4, 0xa2, 0x6e, 0xe0, 0xb7, 0x8b, 0x7c, 0x10, 0xd2,
-	0x97, 0x38, 0xf8, 0xb2, 0x21, 0xc8, 0x84, 0xeb, 0x29, 0xd6, 0x6e, 0xec, 0x9d, 0xba, 0x9e, 0x37,
-	0x25, 0x3e, 0x6d, 0x97, 0xf8, 0x7a, 0x37, 0xf5, 0x3c, 0x03, 0x5f, 0x32, 0x00, 0x19, 0xb0, 0xb5,
-	0x58, 0x66, 0x3f, 0x98, 0xce, 0x3c, 0xc2, 0x57, 0x55, 0xe6, 0xab, 0x6a, 0xe9, 0x39, 0x1c, 0xaf,
-	0x94, 0x46, 0x5d, 0xa8, 0x3a, 0x6e, 0x34, 0x8b, 0x29, 0x69, 0x57, 0xf8, 0xc0, 0x9a, 0x6e, 0x08,
-	0x1a, 0x2b, 0x06, 0xfa, 0x08, 0x36, 0xe5, 0x23, 0x26, 0x51, 0xe0, 0xc5, 0xfc, 0x35, 0x55, 0xb9,
-	0x79, 0x23, 0xcf, 0xc1, 0xcb, 0xc2, 0xe8, 0x26, 0x54, 0x42, 0x72, 0x1a, 0xfb, 0x4e, 0xbb, 0xc6,
-	0x87, 0x55, 0x75, 0xcc, 0x49, 0x2c, 0x61, 0xf4, 0x00, 0x20, 0x72, 0x27, 0xbe, 0x45, 0xe3, 0x90,
-	0x44, 0xed, 0x3a, 0xd7, 0x05, 0xe8, 0x23, 0x05, 0xe1, 0x14, 0xb7, 0xfb, 0xf5, 0x75, 0xa8, 0xca,
-	0x63, 0x44, 0x08, 0x4a, 0x91, 0x17, 0x4f, 0xda, 0xda, 0x2d, 0xed, 0x7e, 0x1d, 0xf3, 0x67, 0x74,
-	0x13, 0x6a, 0x42, 0x65, 0xa6, 0x21, 0xcf, 0xb5, 0xa8, 0x9b, 0x06, 0x4e, 0x40, 0xf4, 0x2e, 0xd4,
-	0xa6, 0x84, 0x5a, 0x8e, 0x45, 0x2d, 0x79, 0x86, 0x9b, 0xca, 0x4c, 0xf4, 0x67, 0x92, 0x81, 0x13,
-	0x11, 0x74, 0x1b, 0x4a, 0x2e, 0x25, 0xd3, 0x76, 0x89, 0x8b, 0xae, 0x27, 0xa2, 0x26, 0x25, 0x53,
-	0xcc, 0x59, 0xa8, 0x07, 0xcd, 0xe8, 0xcc, 0x9d, 0xcd, 0x5c, 0x7f, 0x72, 0x38, 0x63, 0x3b, 0x8e,
-	0xda, 0x65, 0xbe, 0x87, 0x1b, 0x89, 0xf4, 0x28, 0xc3, 0xc7, 0x79, 0x79, 0xd4, 0x85, 0x32, 0xb5,
-	0x2e, 0x48, 0xd4, 0xae, 0xf0, 0x81, 0x8d, 0x64, 0xe0, 0xd8, 0xba, 0xc0, 0x82, 0x85, 0xde, 0x86,
-	0xaa, 0x1d, 0xc4, 0x33, 0x36, 0x7d, 0x95, 0x4b, 0x35, 0x13, 0xa9, 0x3e, 0xc7, 0xb1, 0xe2, 0xa3,
-	0xb7, 0x00, 0xa6, 0x81, 0x43, 0x42, 0x8b, 0x06, 0x61, 0xd4, 0xae, 0xdd, 0x2a, 0xde, 0xaf, 0xe3,
-	0x14, 0x82, 0x74, 0x40, 0x94, 0x84, 0xd3, 0xa8, 0xe7, 0x3b, 0xfd, 0xc0, 0x77, 0x5c, 0xb1, 0xe8,
-	0x3a, 0x57, 0xe3, 0x0a, 0x0e, 0xea, 0x42, 0x43, 0x1c, 0xd5, 0x30, 0xf0, 0x5c, 0x7b, 0xde, 0x06,
-	0x2e, 0x99, 0xc1, 0x3a, 0xbf, 0x2d, 0x42, 0x4d, 0xe9, 0x0f, 0xb5, 0xa1, 0x7a, 0x4e, 0xc2, 0x88,
-	0x99, 0x0a, 0x3b, 0x9c, 0x75, 0xac, 0x48, 0xb4, 0x03, 0x0d, 0x15, 0x09, 0xc6, 0xf3, 0x19, 0xe1,
-	0x67, 0xb4, 0xb1, 0xfd, 0xd6, 0xd2, 0x11, 0xe8, 0xfd, 0x94, 0x14, 0xce, 0x8c, 0x41, 0x8f, 0xa0,
-	0x72, 0x1a, 0x30, 0xa7, 0xe2, 0x07, 0xb8, 0xb1, 0xdd, 0x5e, 0x1e, 0xbd, 0xcb, 0xf9, 0x58, 0xca,
-	0xa1, 0x6d, 0xa8, 0x90, 0x8b, 0x99, 0x1b, 0xce, 0xe5, 0x39, 0x76, 0x74, 0x11, 0x69, 0x74, 0x15,
-	0x69, 0xf4, 0xb1, 0x8a, 0x34, 0x58, 0x4a, 0xa2, 0x07, 0xd0, 0xb2, 0x6c, 0x9b, 0xcc, 0x28, 0x71,
-	0xfa, 0x71, 0x18, 0x12, 0xdf, 0x9e, 0x73, 0xf7, 0xaa, 0xe3, 0x25, 0x1c, 0xdd, 0x87, 0xe6, 0x2c,
-	0x74, 0x6d, 0xd7, 0x9f, 0x24, 0xa2, 0x15, 0x2e, 0x9a, 0x87, 0x51, 0x07, 0x6a, 0x9e, 0xe5, 0x4f,
-	0x62, 0x6b, 0x42, 0xb8, 0x17, 0xd5, 0x71, 0x42, 0x77, 0x87, 0xd0, 0x48, 0xef, 0x1a, 0x6d, 0xc2,
-	0xfa, 0x70, 0xef, 0xf3, 0x91, 0xd9, 0xef, 0xed, 0x1f, 0x3f, 0x39, 0x3c, 0x34, 0x5a, 0x57, 0x50,
-	0x0b, 0x1a, 0x86, 0xf9, 0xc4, 0x1c, 0x2b, 0x44, 0x43, 0x6b, 0x50, 0x1d, 0x0d, 0xf0, 0xa7, 0x66,
-	0x7f, 0xd0, 0x2a, 0xa0, 0x0d, 0x80, 0x3e, 0x3e, 0xfc, 0xcc, 0x38, 0xde, 0x3d, 0x3a, 0x30, 0x5a,
-	0xc5, 0xee, 0x3d, 0xa8, 0x08, 0x4d, 0xa0, 0x26, 0xac, 0xed, 0x9a, 0xdf, 0x1f, 0x18, 0xc7, 0x43,
-	0xcc, 0x44, 0xaf, 0xb0, 0x71, 0xbd, 0xa3, 0xfe, 0xd8, 0x3c, 0x3c, 0x68, 0x69, 0x9d, 0x3f, 0x55,
-	0xa0, 0xc4, 0x2c, 0x1a, 0x6d, 0x41, 0x99, 0xba, 0xd4, 0x23, 0xd2, 0xa7, 0x04, 0x81, 0x6e, 0xc1,
-	0x9a, 0x43, 0x22, 0x3b, 0x74, 0xb9, 0xb9, 0xf2, 0x33, 0xab, 0xe3, 0x34, 0x84, 0xee, 0xc1, 0xc6,
-	0x2c, 0x0c, 0x6c, 0x12, 0x45, 0xae, 0x3f, 0x61, 0xba, 0xe4, 0x47, 0x53, 0xc7, 0x39, 0x94, 0xcd,
-	0xcf, 0x34, 0x42, 0xf8, 0x39, 0x94, 0xb0, 0x20, 0x98, 0x23, 0xfb, 0xd1, 0xe9, 0x97, 0x5c, 0xbd,
-	0x35, 0xcc, 0x9f, 0x19, 0x46, 0xad, 0x89, 0xf0, 0x88, 0x3a, 0xe6, 0xcf, 0xe8, 0x1d, 0xa8, 0xb8,
-	0x53, 0x6b, 0x42, 0x94, 0x07, 0x5c, 0xcd, 0xb8, 0xa3, 0x6e, 0x32, 0x1e, 0x96, 0x22, 0xcc, 0x09,
-	0x6c, 0x8b, 0x92, 0x49, 0x10, 0xba, 0x24, 0x71, 0x82, 0x05, 0xc2, 0x96, 0x32, 0x09, 0xad, 0xa9,
-	0xb0, 0xfb, 0x02, 0x16, 0x04, 0x7a, 0x13, 0xea, 0xb6, 0x32, 0x7c, 0x69, 0xe7, 0x0b, 0x00, 0xe9,
-	0x50, 0x0d, 0xa4, 0x8b, 0xaf, 0xf1, 0x15, 0x6c, 0x65, 0x57, 0x20, 0xfd, 0x5b, 0x09, 0xa1, 0xbb,
-	0x50, 0x8a, 0x9e, 0xc7, 0x51, 0xbb, 0x21, 0xe3, 0x7b, 0x46, 0x78, 0xf4, 0x3c, 0xc6, 0x9c, 0xdd,
-	0xf9, 0x5a, 0x83, 0x8a, 0x18, 0xca, 0x55, 0x61, 0x4d, 0x95, 0xfe, 0xf9, 0xf3, 0x2b, 0xa8, 0xff,
-	0x31, 0xd4, 0xce, 0xad, 0xd0, 0xb5, 0x7c, 0x1a, 0xb5, 0x8b, 0xfc, 0x5d, 0x6f, 0xae, 0x5a, 0x98,
-	0xfe, 0xa9, 0x10, 0xc2, 0x89, 0x74, 0x67, 0x0f, 0xaa, 0x12, 0x5c, 0xf9, 0xea, 0xb7, 0xa1, 0xcc,
-	0xd5, 0x29, 0x63, 0xe9, 0x4a, 0x85, 0x0b, 0x89, 0xce, 0x8f, 0x35, 0x28, 0x8e, 0x9e, 0xc7, 0x2c,
-	0x58, 0xc8, 0xd9, 0xfb, 0xc1, 0xf4, 0x24, 0xe0, 0xb9, 0x78, 0x1d, 0x67, 0x30, 0xa6, 0xe5, 0x59,
-	0x18, 0x38, 0xb1, 0x4d, 0x65, 0x98, 0xae, 0xe3, 0x05, 0xc0, 0xb8, 0x51, 0x1c, 0xda, 0x67, 0x56,
-	0x38, 0x11, 0x76, 0x54, 0xc4, 0x0b, 0x80, 0x79, 0xd0, 0x17, 0xb1, 0xe5, 0x53, 0x97, 0x0a, 0x6f,
-	0x2e, 0xe2, 0x84, 0xee, 0x7c, 0xa5, 0x41, 0x99, 0x2f, 0x8a, 0x49, 0x9d, 0xba, 0x1e, 0x49, 0x6d,
-	0x28, 0xa1, 0x19, 0x2f, 0x08, 0xdd, 0x89, 0xeb, 0x5b, 0x9e, 0x7c, 0x79, 0x42, 0x33, 0xab, 0xf0,
-	0x92, 0xf7, 0xd6, 0xb1, 0x20, 0xd0, 0x75, 0xa8, 0x4c, 0x89, 0xe3, 0xc6, 0x22, 0x0f, 0xd4, 0xb1,
-	0xa4, 0x98, 0x74, 0x34, 0xb5, 0x3c, 0x4f, 0x06, 0x06, 0x41, 0x70, 0xd3, 0x75, 0x7d, 0x15, 0x02,
-	0xf8, 0x73, 0xe7, 0xd7, 0x15, 0xd8, 0xc8, 0x66, 0x81, 0x95, 0xfa, 0x7e, 0x0c, 0x25, 0xba, 0x08,
-	0x8b, 0x77, 0x2e, 0x49, 0x20, 0x09, 0xc9, 0x83, 0x23, 0x1f, 0x81, 0xee, 0x41, 0x35, 0x24, 0x13,
-	0x6e, 0x9a, 0xcc, 0x02, 0x36, 0xb6, 0x1b, 0x2c, 0x2d, 0xb0, 0x0a, 0xab, 0x1f, 0x38, 0x04, 0x2b,
-	0x26, 0x7a, 0x0a, 0xeb, 0x2a, 0xfb, 0xe0, 0xd8, 0x23, 0x91, 0x8c, 0x88, 0x77, 0x5f, 0xf6, 0x2a,
-	0x2e, 0x8c, 0xb3, 0x63, 0xd1, 0x07, 0x50, 0x8b, 0x48, 0x78, 0xee, 0xda, 0x44, 0xe5, 0xbc, 0x9b,
-	0x97, 0xce, 0x23, 0xe4, 0x70, 0x32, 0xa0, 0x63, 0x41, 0x55, 0x82, 0x2b, 0x55, 0x91, 0x84, 0x8a,
-	0x42, 0x3a, 0x54, 0x3c, 0x84, 0x4d, 0x12, 0x51, 0x77, 0x6a, 0x51, 0xe2, 0x18, 0xc4, 0x73, 0xcf,
-	0x49, 0x38, 0x97, 0x67, 0xb5, 0xcc, 0xe8, 0xfc, 0xac, 0x08, 0xeb, 0x99, 0x0d, 0xa0, 0x8f, 0xa1,
-	0x16, 0xc6, 0x1e, 0xe1, 0xb9, 0x47, 0xe3, 0x4a, 0xd6, 0x5f, 0x69, 0xe7, 0x3a, 0x96, 0xa3, 0x70,
-	0x32, 0x1e, 0x7d, 0x04, 0xe5, 0x90, 0xab, 0xb0, 0xc0, 0xb7, 0xfe, 0xe0, 0xd5, 0x27, 0xc2, 0x62,
-	0x60, 0x67, 0x0c, 0x25, 0x46, 0x32, 0x8b, 0x9c, 0xba, 0x3e, 0xb6, 0xfc, 0x09, 0x91, 0x09, 0x33,
-	0xa1, 0x39, 0xcf, 0xba, 0x10, 0xbc, 0x82, 0xe4, 0x49, 0x7a, 0xa1, 0xa3, 0x62, 0x4a, 0x47, 0xdd,
-	0x5f, 0x68, 0x50, 0x53, 0xcb, 0x45, 0xd7, 0x60, 0xf3, 0x93, 0xa3, 0xde, 0xc1, 0xd8, 0x1c, 0x7f,
-	0x7e, 0x6c, 0x98, 0xa3, 0xfe, 0xe1, 0xd1, 0xc1, 0xb8, 0x75, 0x05, 0x7d, 0x03, 0x6e, 0xec, 0xee,
-	0xf7, 0xc6, 0xc7, 0xbb, 0x83, 0xc1, 0x71, 0xc2, 0xc7, 0xbd, 0x83, 0x27, 0x83, 0x96, 0x86, 0xde,
-	0x80, 0x6b, 0x09, 0xf3, 0xb3, 0x81, 0xf9, 0x64, 0x6f, 0x2c, 0x59, 0x05, 0xc6, 0xea, 0x1f, 0x3e,
-	0xdb, 0x31, 0x0f, 0x06, 0xc6, 0xf1, 0x68, 0xcf, 0x1c, 0x0e, 0xcd, 0x83, 0x27, 0xc7, 0x3d, 0xc3,
-	0x68, 0x15, 0xd1, 0x5b, 0xd0, 0x59, 0x66, 0x8d, 0x8e, 0x76, 0xc6, 0xb8, 0xd7, 0x1f, 0xb7, 0x4a,
-	0xdd, 0xf7, 0xa1, 0x91, 0xb6, 0x5b, 0x96, 0xcb, 0xf6, 0x0f, 0x59, 0x6e, 0x1b, 0x9a, 0xfd, 0xa7,
-	0x47, 0xc3, 0xd6, 0x95, 0x7c, 0x92, 0xd2, 0x3a, 0x3f, 0xd7, 0xa0, 0x38, 0xb6, 0x2e, 0x58, 0x3d,
-	0x41, 0xad, 0x8b, 0xe4, 0xd0, 0xea, 0x58, 0x91, 0xe8, 0x21, 0x00, 0xb5, 0x2e, 0xb0, 0xb4, 0xfc,
-	0xc2, 0x0a, 0xcb, 0x4f, 0xf1, 0x59, 0x24, 0xa5, 0xd6, 0x85, 0x5a, 0x05, 0xd7, 0x5a, 0x0d, 0xa7,
-	0x21, 0x96, 0x35, 0x66, 0x24, 0xb4, 0x89, 0x4f, 0x59, 0xd4, 0x2b, 0xf1, 0xd4, 0x90, 0x42, 0x78,
-	0xa8, 0x16, 0xe5, 0xd6, 0x25, 0xb9, 0x72, 0x0b, 0x4a, 0x67, 0x56, 0x74, 0x26, 0x02, 0xcb, 0xde,
-	0x15, 0xcc, 0x29, 0x74, 0x07, 0x1a, 0x8e, 0x1b, 0xf1, 0x96, 0x87, 0x2d, 0x4a, 0x58, 0xec, 0xde,
-	0x15, 0x9c, 0x41, 0xd1, 0x03, 0x68, 0xca, 0x57, 0x19, 0x12, 0xe6, 0x81, 0xa5, 0xb0, 0xa7, 0xe1,
-	0x3c, 0x03, 0xdd, 0x83, 0x75, 0x7e, 0xda, 0x89, 0x24, 0x8b, 0x36, 0xa5, 0x3d, 0x0d, 0x67, 0xe1,
-	0x9d, 0x0a, 0x94, 0x58, 0x8b, 0xb5, 0x03, 0x50, 0x53, 0xef, 0xea, 0xfe, 0xaa, 0x0e, 0x65, 0xd1,
-	0xe0, 0xdc, 0x81, 0x75, 0x51, 0xc5, 0xf5, 0x1c, 0x27, 0x24, 0x51, 0x24, 0xf7, 0x92, 0x05, 0x59,
-	0x40, 0x16, 0xc0, 0x2e, 0x51, 0xee, 0xb8, 0x00, 0xd0, 0x3b, 0x50, 0x8b, 0xd2, 0x1a, 0x65, 0x95,
-	0x29, 0x9f, 0x7d, 0x61, 0xf8, 0x89, 0x00, 0xfa, 0x26, 0x54, 0x79, 0x2b, 0x62, 0x1a, 0x32, 0xf0,
-	0xf0, 0xf2, 0x5c, 0x61, 0xe8, 0x31, 0xd4, 0x93, 0x9e, 0x4f, 0x36, 0x33, 0x2f, 0xaa, 0xd5, 0x16,
-	0xc2, 0xe8, 0x36, 0x94, 0x59, 0x35, 0xae, 0x4a, 0xe8, 0x35, 0xb9, 0x04, 0x5e, 0xa7, 0x0b, 0x0e,
-	0xba, 0x0f, 0xd5, 0x99, 0x35, 0xe7, 0x0d, 0x97, 0x68, 0x60, 0x36, 0xa4, 0xd0, 0x50, 0xa0, 0x58,
-	0xb1, 0x99, 0x15, 0x84, 0x16, 0x73, 0xe5, 0xa7, 0x64, 0x2e, 0x6a, 0x87, 0x06, 0x4e, 0x21, 0x68,
-	0x1b, 0xb6, 0x2c, 0x8f, 0x92, 0xd0, 0xb7, 0x28, 0x61, 0x25, 0x9b, 0x65, 0x53, 0xd3, 0x3f, 0x0d,
-	0x64, 0x09, 0xbd, 0x92, 0xd7, 0xf9, 0x83, 0x06, 0xb5, 0xc4, 0xcc, 0xae, 0x43, 0x85, 0xa9, 0x64,
-	0x1c, 0x48, 0x85, 0x4b, 0x8a, 0x19, 0xba, 0x25, 0x4f, 0x42, 0x64, 0x26, 0x45, 0xb2, 0x10, 0x69,
-	0xb3, 0x94, 0x27, 0x62, 0x1d, 0x7f, 0xe6, 0xe9, 0x87, 0x5a, 0x94, 0xc8, 0xac, 0x24, 0x08, 0x6e,
-	0xc2, 0x41, 0x44, 0x2d, 0x8f, 0x5b, 0x9a, 0xc8, 0x4c, 0x29, 0x84, 0x65, 0x0a, 0xd9, 0x7b, 0x73,
-	0x9b, 0x59, 0xca, 0x14, 0x92, 0xc9, 0x12, 0xb9, 0x7c, 0xf9, 0x41, 0x40, 0x79, 0xcd, 0xc5, 0xab,
-	0xfe, 0x34, 0xd6, 0xf9, 0x73, 0x41, 0x16, 0x8e, 0xb7, 0x60, 0xcd, 0x13, 0xd1, 0x6f, 0x8f, 0x59,
-	0xbf, 0xd8, 0x55, 0x1a, 0xca, 0xe4, 0x6d, 0x19, 0xc7, 0x14, 0x8d, 0x1e, 0x2e, 0xea, 0x2a, 0x51,
-	0xbe, 0xa0, 0xd4, 0xf1, 0x2d, 0x55, 0x55, 0x3b, 0xb0, 0x91, 0x6d, 0xa0, 0x92, 0xaa, 0x3e, 0x35,
-	0x28, 0xd7, 0x72, 0xe5, 0x46, 0x30, 0x75, 0x4e, 0xc9, 0x34, 0x90, 0xea, 0xe1, 0xcf, 0x6c, 0x0f,
-	0xa2, 0x83, 0x62, 0x7a, 0x50, 0x95, 0x67, 0x1a, 0xea, 0x6c, 0xbf, 0xb0, 0x4e, 0xdb, 0x82, 0xf2,
-	0xb9, 0xe5, 0xc5, 0x44, 0x1e, 0x9d, 0x20, 0x3a, 0xdf, 0x7b, 0xa5, 0xc4, 0xdf, 0x86, 0xaa, 0x4c,
-	0x8c, 0xea, 0xe0, 0x25, 0xd9, 0xf9, 0x49, 0x01, 0xaa, 0xd2, 0x40, 0xd1, 0xbb, 0xac, 0x0e, 0xa1,
-	0x67, 0x81, 0x23, 0x73, 0xd7, 0xb5, 0xac, 0x01, 0xb3, 0xfe, 0xe7, 0x2c, 0x70, 0xb0, 0x14, 0x62,
-	0x7e, 0x9b, 0x74, 0x7d, 0xaa, 0xcc, 0x4a, 0x00, 0x66, 0x83, 0xd6, 0x94, 0x87, 0x0e, 0x91, 0x3d,
-	0x24, 0xc5, 0x4b, 0xe0, 0x33, 0xcb, 0xf5, 0x59, 0xd8, 0x90, 0x96, 0xb5, 0x00, 0xd2, 0x16, 0x5a,
-	0xce, 0x5a, 0x28, 0xef, 0x12, 0x1d, 0x42, 0xa6, 0x23, 0x5e, 0x97, 0xca, 0xf2, 0x27, 0x83, 0x75,
-	0x1f, 0x43, 0x45, 0xac, 0x11, 0x5d, 0x85, 0x66, 0xcf, 0x30, 0xf0, 0x60, 0x34, 0x3a, 0xc6, 0x83,
-	0x4f, 0x8e, 0x06, 0x23, 0x96, 0x95, 0x00, 0x2a, 0x86, 0x89, 0x07, 0xfd, 0x71, 0x4b, 0x43, 0xeb,
-	0x50, 0x7f, 0x76, 0x68, 0x0c, 0x70, 0x6f, 0x3c, 0x30, 0x5a, 0x85, 0xee, 0x3f, 0x34, 0xd8, 0x5c,
-	0xbe, 0x52, 0x69, 0x43, 0x35, 0x60, 0xa0, 0x69, 0xa8, 0xc4, 0x20, 0xc9, 0x6c, 0x24, 0x29, 0xbc,
-	0x4e, 0x24, 0x61, 0xbd, 0x8c, 0xd0, 0xa7, 0x0a, 0x8a, 0xaa, 0x97, 0xc9, 0xa0, 0xac, 0xe9, 0x0b,
-	0xc9, 0x17, 0x31, 0x89, 0x28, 0x71, 0x7a, 0x42, 0x91, 0xa2, 0xab, 0xc9, 0xc3, 0xe8, 0xbb, 0xd0,
-	0x12, 0xc1, 0x63, 0xb4, 0xb8, 0xe6, 0x10, 0xe5, 0x52, 0x4b, 0xc7, 0x59, 0x06, 0x5e, 0x92, 0xec,
-	0xfe, 0x54, 0x83, 0x35, 0x71, 0x35, 0x45, 0x7e, 0x48, 0x6c, 0xfa, 0x3f, 0xd9, 0x33, 0x6b, 0x54,
-	0xdc, 0x89, 0xf2, 0xbe, 0x4d, 0x7d, 0xc7, 0xa5, 0x76, 0xe0, 0xfa, 0x8b, 0x65, 0x71, 0x76, 0xf7,
-	0xaf, 0x1a, 0x34, 0x73, 0x0b, 0x46, 0x1f, 0xa5, 0x2e, 0x54, 0x34, 0xfe, 0xce, 0x3b, 0xf9, 0x4d,
-	0xe9, 0xe3, 0xd0, 0xf2, 0x23, 0xcb, 0x66, 0x47, 0xb6, 0xe2, 0x8e, 0x85, 0xd5, 0xfb, 0x4a, 0x94,
-	0x2f, 0xbb, 0x81, 0x17, 0x40, 0x67, 0x0e, 0x57, 0x57, 0x0c, 0x4f, 0x05, 0x9c, 0xd1, 0xe2, 0x0e,
-	0x28, 0x0d, 0xf1, 0xac, 0xa5, 0x42, 0xb6, 0x9a, 0x36, 0x01, 0x98, 0xb5, 0x26, 0xae, 0xc0, 0x04,
-	0x8a, 0x5c, 0x20, 0x83, 0x75, 0x87, 0xd0, 0xca, 0x2b, 0x82, 0x45, 0x57, 0xd7, 0x9f, 0xc5, 0xd4,
-	0xf4, 0x1d, 0x72, 0x21, 0x8b, 0xb5, 0x14, 0xf2, 0xe2, 0xcd, 0x74, 0x7f, 0x53, 0x86, 0xd6, 0xd2,
-	0x65, 0x5e, 0x72, 0xa0, 0x4e, 0xf6, 0x40, 0x9d, 0xe4, 0x86, 0xab, 0x90, 0xba, 0xe1, 0xca, 0x1c,
-	0x72, 0xf1, 0x75, 0x0e, 0xf9, 0x00, 0x5a, 0xb3, 0xb3, 0x79, 0xe4, 0xda, 0x96, 0x97, 0x94, 0xce,
-	0xe2, 0xe6, 0xb1, 0xbb, 0x74, 0xf3, 0xa8, 0x0f, 0x73, 0x92, 0x78, 0x69, 0x2c, 0x7a, 0x0a, 0x4d,
-	0xc7, 0x9d, 0xb8, 0x34, 0x35, 0x9d, 0xb0, 0xea, 0xdb, 0xcb, 0xd3, 0x19, 0x59, 0x41, 0x9c, 0x1f,
-	0x89, 0x1e, 0x41, 0x65, 0x66, 0xcd, 0x83, 0x98, 0xca, 0xab, 0xc8, 0xf6, 0x8a, 0x25, 0x71, 0x3e,
-	0x96, 0x72, 0xe8, 0x3b, 0xd0, 0xcc, 0xf9, 0x8a, 0x4c, 0xeb, 0xcb, 0x4e, 0x95, 0x17, 0xe4, 0x21,
-	0x38, 0xa0, 0x84, 0xdf, 0x48, 0xb2, 0x10, 0x1c, 0x50, 0xd2, 0x19, 0x43, 0x2b, 0xbf, 0x69, 0x1e,
-	0x96, 0x59, 0xf0, 0x26, 0xa1, 0x3a, 0x1a, 0x49, 0xb2, 0x28, 0x41, 0x43, 0xcb, 0x7e, 0xee, 0xfa,
-	0x93, 0x83, 0x78, 0x7a, 0x42, 0x54, 0x80, 0xcd, 0xa1, 0x9d, 0x0f, 0xa1, 0x99, 0xdb, 0x3b, 0x6a,
-	0x41, 0x31, 0x0e, 0x3d, 0x39, 0x21, 0x7b, 0x64, 0xb9, 0x71, 0x66, 0x45, 0xd1, 0x97, 0x41, 0xe8,
-	0xa8, 0x8e, 0x54, 0xd1, 0xac, 0xaf, 0xae, 0x88, 0x9d, 0x27, 0x5e, 0xaa, 0xbd, 0xd0, 0x4b, 0x59,
-	0x51, 0x27, 0x54, 0xd4, 0xcb, 0x94, 0x12, 0x59, 0x10, 0x3d, 0x80, 0x96, 0x00, 0x76, 0x09, 0x19,
-	0x92, 0x70, 0x67, 0x4e, 0x55, 0x1b, 0xb1, 0x84, 0x77, 0x7f, 0xa7, 0x41, 0x33, 0x7f, 0x79, 0x7c,
-	0xb9, 0xd5, 0xfe, 0xe7, 0x61, 0xe8, 0x7d, 0x00, 0xf1, 0xee, 0xd1, 0x0b, 0x83, 0x51, 0x4a, 0x08,
-	0xdd, 0x86, 0xaa, 0x38, 0xdc, 0x48, 0xda, 0x72, 0x55, 0x9e, 0x3e, 0x56, 0x78, 0xf7, 0xef, 0x25,
-	0xa8, 0x08, 0x0c, 0x6d, 0xab, 0xc2, 0xce, 0x58, 0x84, 0x2b, 0x24, 0x07, 0xc8, 0x1f, 0xc6, 0xc1,
-	0x29, 0xa9, 0x97, 0x84, 0xa7, 0xaf, 0x4a, 0x00, 0x38, 0x23, 0xbc, 0x08, 0x3a, 0x5a, 0x3e, 0xe8,
-	0xbc, 0xf4, 0x76, 0x5a, 0x87, 0xba, 0x78, 0x1e, 0xb9, 0xaa, 0x98, 0x5e, 0xb6, 0xe6, 0x85, 0xc8,
-	0xcb, 0xca, 0xe9, 0x37, 0xa1, 0xce, 0x1f, 0x0f, 0x58, 0xb9, 0x21, 0xd2, 0xf5, 0x02, 0x60, 0x56,
-	0xc7, 0x09, 0xf6, 0xae, 0x0a, 0x5f, 0x6a, 0x42, 0xa3, 0xbb, 0xb0, 0x96, 0x84, 0x42, 0xd3, 0x90,
-	0x8e, 0xc5, 0x27, 0x4f, 0xe3, 0x99, 0x28, 0xca, 0xa6, 0xa9, 0xe5, 0xa2, 0x28, 0x9b, 0x2a, 0x63,
-	0x0e, 0xf5, 0xd7, 0x31, 0x07, 0x66, 0x62, 0xe7, 0x24, 0xb4, 0x3c, 0x8f, 0x5f, 0xc5, 0xad, 0x63,
-	0x45, 0x32, 0xce, 0x17, 0xb1, 0xe5, 0xb1, 0x5a, 0x72, 0x4d, 0x70, 0x24, 0x99, 0xbf, 0x2c, 0x6b,
-	0x70, 0x6e, 0xe6, 0xb2, 0xec, 0x0e, 0xac, 0x3b, 0xd2, 0x15, 0x47, 0x33, 0x42, 0x9c, 0xf6, 0x3a,
-	0x97, 0xc9, 0x82, 0x2c, 0xbb, 0xdb, 0x71, 0x44, 0x83, 0x29, 0x09, 0xe5, 0x2d, 0x45, 0x7b, 0x83,
-	0xcb, 0xe5, 0x61, 0x56, 0x47, 0x85, 0xe4, 0xdc, 0x25, 0x5f, 0xb6, 0x9b, 0xa2, 0x96, 0x17, 0x54,
-	0xf7, 0x8f, 0x1a, 0x54, 0xe5, 0x07, 0x92, 0xac, 0x0e, 0xb4, 0xd7, 0xd1, 0xc1, 0x16, 0x94, 0x6d,
-	0xcf, 0x72, 0xa7, 0xaa, 0xa8, 0xe4, 0xc4, 0xb2, 0x8b, 0x17, 0x57, 0xb9, 0xf8, 0xb7, 0xa0, 0x1e,
-	0xc4, 0x74, 0x16, 0xb8, 0x3e, 0x55, 0xde, 0x51, 0xd7, 0x0f, 0x25, 0x82, 0x17, 0x3c, 0xa4, 0x03,
-	0x8a, 0x48, 0xe8, 0x5a, 0x9e, 0xfb, 0x23, 0xe2, 0xa8, 0x3b, 0x68, 0x6e, 0x30, 0x0d, 0xbc, 0x82,
-	0xd3, 0xfd, 0x5b, 0x09, 0x36, 0x97, 0xbe, 0xfd, 0xfc, 0x17, 0x9b, 0x4c, 0xc5, 0x92, 0x42, 0x36,
-	0x96, 0xb0, 0x66, 0x26, 0x0c, 0x66, 0x41, 0x44, 0x9c, 0x1d, 0xd5, 0xfc, 0xa4, 0x10, 0xde, 0xa9,
-	0x2d, 0xbe, 0x4b, 0x89, 0x6a, 0x35, 0x85, 0xa0, 0xf7, 0x93, 0xb4, 0x22, 0xba, 0xc9, 0x37, 0x96,
-	0xbf, 0x59, 0xe5, 0xf3, 0xca, 0x23, 0xb8, 0x9a, 0xd8, 0x6f, 0xe2, 0x7a, 0xa2, 0x1d, 0x68, 0xe0,
-	0x55, 0xac, 0xce, 0x5f, 0x0a, 0xaf, 0x1b, 0xa2, 0x6f, 0x43, 0x85, 0xd7, 0x0c, 0xea, 0xee, 0x28,
-	0x75, 0x2c, 0x92, 0x81, 0x76, 0x60, 0x4d, 0x7c, 0xb4, 0x8b, 0xe9, 0x2c, 0xa6, 0x32, 0x18, 0xdc,
-	0xba, 0x74, 0xf9, 0xba, 0x90, 0xc3, 0xe9, 0x41, 0xc8, 0x80, 0x86, 0xfc, 0x80, 0x28, 0x26, 0x29,
-	0xbd, 0xe2, 0x24, 0x99, 0x51, 0xe8, 0x63, 0x68, 0x26, 0xbb, 0x96, 0x13, 0x95, 0x5f, 0x71, 0xa2,
-	0xfc, 0xc0, 0xce, 0x63, 0xa8, 0xc8, 0x59, 0x59, 0x0b, 0x2c, 0x1a, 0x05, 0xd5, 0x02, 0x73, 0x2a,
-	0xd5, 0x96, 0x14, 0xd2, 0x6d, 0x49, 0xf7, 0x63, 0xa8, 0x29, 0x1d, 0xb1, 0xf4, 0x7d, 0xb6, 0x68,
-	0x33, 0xc5, 0x15, 0xcb, 0x16, 0x94, 0x5d, 0x5e, 0x93, 0x89, 0xe6, 0x52, 0x10, 0x8b, 0x9e, 0x4c,
-	0xde, 0x90, 0x71, 0xa2, 0xfb, 0xcb, 0x02, 0x54, 0xc4, 0x47, 0xc8, 0xff, 0x63, 0x35, 0x8d, 0x06,
-	0xb0, 0x29, 0x6e, 0x51, 0x52, 0xf5, 0xad, 0x3c, 0xa2, 0x1b, 0xf2, 0x1b, 0x69, 0xba, 0x72, 0x36,
-	0xfd, 0xd3, 0x00, 0x2f, 0x8f, 0x58, 0xd5, 0xca, 0x76, 0x3e, 0x80, 0x66, 0x6e, 0x24, 0xbf, 0x95,
-	0xbe, 0x70, 0x55, 0xb2, 0xe6, 0xcf, 0xd9, 0x8e, 0x35, 0xd1, 0xce, 0xef, 0x35, 0x28, 0x98, 0x06,
-	0x3b, 0x88, 0x19, 0x49, 0x29, 0x46, 0x52, 0x2c, 0xe6, 0x9f, 0x78, 0x81, 0xfd, 0x9c, 0xf7, 0x84,
-	0xc9, 0xfd, 0x7d, 0x06, 0x43, 0x77, 0xa1, 0x3a, 0x8b, 0x4f, 0x9e, 0x93, 0x79, 0x24, 0x0d, 0x77,
-	0x4d, 0x37, 0x0d, 0x7d, 0x28, 0x20, 0xac, 0x78, 0xcc, 0x7b, 0x4f, 0x12, 0xdd, 0xf0, 0xad, 0x37,
-	0x70, 0x0a, 0xe9, 0x7c, 0x08, 0x55, 0x39, 0x86, 0x25, 0x2b, 0xd7, 0x21, 0xe2, 0xfa, 0x40, 0xe4,
-	0xd5, 0x84, 0x66, 0x67, 0x28, 0x07, 0xc9, 0xfc, 0xac, 0xc8, 0xee, 0x3f, 0x35, 0xa8, 0x2f, 0xaa,
-	0xbe, 0x87, 0xac, 0xc9, 0x16, 0x6a, 0x16, 0xfd, 0x33, 0x5a, 0x7c, 0x65, 0xd6, 0x47, 0x82, 0x83,
-	0x95, 0x08, 0xab, 0xf0, 0x92, 0x34, 0xcf, 0xaa, 0xa0, 0x48, 0x4e, 0x9e, 0x43, 0xbb, 0x5f, 0x69,
-	0x50, 0x95, 0x83, 0xd1, 0x1a, 0x54, 0xf7, 0xcd, 0xd1, 0xd8, 0x3c, 0x78, 0xd2, 0xba, 0x82, 0xea,
-	0x50, 0x3e, 0xc4, 0xc6, 0x00, 0xb7, 0x34, 0x74, 0x1d, 0x10, 0x7f, 0x3c, 0xee, 0x1f, 0x1e, 0xec,
-	0x9a, 0xf8, 0x59, 0x8f, 0x7f, 0x78, 0x2b, 0xa0, 0x6b, 0xb0, 0x29, 0xf0, 0xdd, 0xa3, 0xfd, 0x5d,
-	0x73, 0x7f, 0xff, 0xd9, 0xe0, 0x60, 0xdc, 0x2a, 0xa2, 0x2d, 0x68, 0x29, 0xf1, 0x67, 0xc3, 0xfd,
-	0x01, 0x17, 0x2e, 0xb1, 0xc9, 0x0d, 0x73, 0x34, 0x3c, 0x1a, 0x0f, 0x5a, 0x65, 0x36, 0xa3, 0x24,
-	0x8e, 0xf1, 0x60, 0x74, 0xb8, 0x7f, 0xc4, 0x85, 0x2a, 0xac, 0x85, 0xc6, 0x03, 0xfe, 0xf9, 0xaf,
-	0xda, 0x25, 0xb0, 0xce, 0xf6, 0x47, 0x1c, 0xf5, 0xc5, 0xbc, 0x0b, 0x55, 0xd9, 0x21, 0xc9, 0xf8,
-	0xbc, 0xf8, 0x8b, 0x84, 0x62, 0x24, 0xbe, 0x55, 0x48, 0xf9, 0x56, 0xa6, 0x04, 0x2a, 0xe6, 0x4a,
-	0xa0, 0x9d, 0xd2, 0x0f, 0x0a, 0xb3, 0x93, 0x93, 0x0a, 0xf7, 0x89, 0x6f, 0xff, 0x3b, 0x00, 0x00,
-	0xff, 0xff, 0x8b, 0x6e, 0x8d, 0xeb, 0xea, 0x21, 0x00, 0x00,
+	// 3571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0x1b, 0x59,
+	0x72, 0x37, 0xbf, 0xc9, 0x12, 0x25, 0x52, 0xcf, 0x1a, 0x9b, 0xc3, 0xf5, 0x8e, 0xe5, 0x8e, 0xed,
+	0x78, 0xbc, 0xde, 0xde, 0x19, 0x05, 0x01, 0x8c, 0x6c, 0x90, 0x1d, 0x8a, 0x4d, 0x59, 0x6d, 0xcb,
+	0x24, 0xe7, 0x91, 0xda, 0xdd, 0xc9, 0x45, 0x68, 0xb1, 0x9f, 0xa8, 0x1e, 0x37, 0xbb, 0x39, 0xdd,
+	0xaf, 0x65, 0x29, 0xb7, 0x04, 0x08, 0x90, 0xcc, 0x25, 0x39, 0x04, 0x98, 0x43, 0xfe, 0x83, 0xdc,
+	0x82, 0xdc, 0x92, 0x5b, 0x0e, 0x41, 0x6e, 0x01, 0x72, 0xca, 0x21, 0x40, 0x82, 0x5c, 0x33, 0x97,
+	0x00, 0xf9, 0x03, 0x82, 0xf7, 0xd5, 0x5f, 0xa4, 0x6c, 0x39, 0xc1, 0x20, 0xb7, 0xae, 0x5f, 0x55,
+	0xbd, 0x7e, 0x1f, 0x55, 0xf5, 0xaa, 0xaa, 0x1b, 0x5a, 0x33, 0xdf, 0xa3, 0x81, 0x35, 0xa3, 0xa1,
+	0xbe, 0x0c, 0x7c, 0xea, 0x77, 0xd1, 0xcc, 0x8f, 0x3c, 0x1a, 0x5c, 0xcd, 0x7c, 0x9b, 0x28, 0xec,
+	0xfe, 0xdc, 0xf7, 0xe7, 0x2e, 0xf9, 0x19, 0xa7, 0x4e, 0xa3, 0xb3, 0x9f, 0x51, 0x67, 0x41, 0x42,
+	0x6a, 0x2d, 0x96, 0x42, 0x40, 0xfb, 0xf3, 0x0a, 0x6c, 0x63, 0x67, 0x66, 0x05, 0xb6, 0x63, 0x79,
+	0x7d, 0x39, 0x22, 0xfa, 0x0c, 0xb6, 0x2e, 0x88, 0x67, 0xfb, 0xc1, 0x91, 0x13, 0x52, 0xc7, 0x9b,
+	0x87, 0x9d, 0xc2, 0x6e, 0xe9, 0xc9, 0xc6, 0x5e, 0x5d, 0x97, 0x00, 0xce, 0xf1, 0xd1, 0x63, 0x80,
+	0xd3, 0xe8, 0x8a, 0x04, 0xa3, 0xc0, 0x26, 0x41, 0xa7, 0xb8, 0x5b, 0x78, 0xb2, 0xb1, 0x57, 0xd5,
+	0x39, 0x85, 0x53, 0x1c, 0x74, 0x04, 0x77, 0x85, 0x26, 0x27, 0xfb, 0xbe, 0x77, 0xe6, 0x04, 0x0b,
+	0x8b, 0x3a, 0xbe, 0xd7, 0x29, 0x71, 0x25, 0xa4, 0xaf, 0x70, 0xf0, 0x75, 0x2a, 0xc8, 0x84, 0x3b,
+	0x29, 0xd6, 0x41, 0xe4, 0x9e, 0x39, 0xae, 0xbb, 0x20, 0x1e, 0xed, 0x94, 0xf9, 0x7c, 0xb7, 0xf5,
+	0x3c, 0x03, 0x5f, 0xa3, 0x80, 0x0c, 0xd8, 0x49, 0xa6, 0xd9, 0xf7, 0x17, 0x4b, 0x97, 0xf0, 0x59,
+	0x55, 0xf8, 0xac, 0xda, 0x7a, 0x0e, 0xc7, 0x6b, 0xa5, 0x91, 0x06, 0x35, 0xdb, 0x09, 0x97, 0x11,
+	0x25, 0x9d, 0x2a, 0x57, 0xac, 0xeb, 0x86, 0xa0, 0xb1, 0x62, 0xa0, 0x2f, 0x60, 0x5b, 0x3e, 0x62,
+	0x12, 0xfa, 0x6e, 0xc4, 0x5f, 0x53, 0x93, 0x8b, 0x37, 0xf2, 0x1c, 0xbc, 0x2a, 0x8c, 0xee, 0x43,
+	0x35, 0x20, 0x67, 0x91, 0x67, 0x77, 0xea, 0x5c, 0xad, 0xa6, 0x63, 0x4e, 0x62, 0x09, 0xa3, 0xa7,
+	0x00, 0xa1, 0x33, 0xf7, 0x2c, 0x1a, 0x05, 0x24, 0xec, 0x34, 0xf8, 0x5e, 0x80, 0x3e, 0x51, 0x10,
+	0x4e, 0x71, 0xd1, 0x61, 0x66, 0x0f, 0x7b, 0xf6, 0xd7, 0x51, 0x48, 0xd9, 0x8e, 0x84, 0x1d, 0xd8,
+	0x2d, 0x25, 0x4b, 0x4f, 0x18, 0xf8, 0x1a, 0x79, 0x34, 0x80, 0xdb, 0x72, 0xae, 0x03, 0xc6, 0x0f,
+	0x89, 0x18, 0x66, 0x83, 0x0f, 0x73, 0x5b, 0x37, 0x56, 0x78, 0x78, 0x9d, 0xbc, 0xf6, 0xc7, 0x1f,
+	0x43, 0x4d, 0xda, 0x15, 0x42, 0x50, 0x0e, 0xdd, 0x68, 0xde, 0x29, 0xec, 0x16, 0x9e, 0x34, 0x30,
+	0x7f, 0x46, 0xf7, 0xa1, 0x2e, 0x26, 0x60, 0x1a, 0xd2, 0xd0, 0x4a, 0xba, 0x69, 0xe0, 0x18, 0x44,
+	0x3f, 0x85, 0xfa, 0x82, 0x50, 0xcb, 0xb6, 0xa8, 0x25, 0x8d, 0x6a, 0x5b, 0xd9, 0xad, 0xfe, 0x5a,
+	0x32, 0x70, 0x2c, 0x82, 0x1e, 0x40, 0xd9, 0xa1, 0x64, 0xd1, 0x29, 0x73, 0xd1, 0xcd, 0x58, 0xd4,
+	0xa4, 0x64, 0x81, 0x39, 0x0b, 0xf5, 0xa0, 0x15, 0x9e, 0x3b, 0xcb, 0xa5, 0xe3, 0xcd, 0x47, 0x4b,
+	0x76, 0x04, 0x61, 0xa7, 0xc2, 0x57, 0x75, 0x37, 0x96, 0x9e, 0x64, 0xf8, 0x38, 0x2f, 0x8f, 0x34,
+	0xa8, 0x50, 0xeb, 0x92, 0x84, 0x9d, 0x2a, 0x57, 0x6c, 0xc6, 0x8a, 0x53, 0xeb, 0x12, 0x0b, 0x16,
+	0xfa, 0x14, 0x6a, 0x33, 0x3f, 0x5a, 0xb2, 0xe1, 0x6b, 0x5c, 0xaa, 0x15, 0x4b, 0xf5, 0x39, 0x8e,
+	0x15, 0x1f, 0x7d, 0x02, 0xb0, 0xf0, 0x6d, 0x12, 0x58, 0xd4, 0x0f, 0xc2, 0x4e, 0x7d, 0xb7, 0xf4,
+	0xa4, 0x81, 0x53, 0x08, 0xd2, 0x01, 0x51, 0x12, 0x2c, 0xc2, 0x9e, 0x67, 0xf7, 0x7d, 0xcf, 0x76,
+	0xc4, 0xa4, 0x1b, 0x7c, 0x1b, 0xd7, 0x70, 0x90, 0x06, 0x4d, 0x61, 0x3b, 0x63, 0xdf, 0x75, 0x66,
+	0x57, 0x1d, 0xe0, 0x92, 0x19, 0x0c, 0xbd, 0x02, 0x14, 0x10, 0x1a, 0x05, 0x9e, 0xe9, 0x85, 0x34,
+	0x88, 0x66, 0x62, 0xcc, 0x0d, 0xbe, 0x6d, 0x3f, 0x8a, 0x67, 0x8a, 0x57, 0x44, 0xf0, 0x1a, 0xb5,
+	0xee, 0xdf, 0x96, 0xa0, 0xae, 0x0e, 0x03, 0x75, 0xa0, 0x76, 0x41, 0x82, 0x90, 0x39, 0x02, 0x3b,
+	0xe9, 0x4d, 0xac, 0x48, 0xb4, 0x0f, 0x4d, 0x15, 0xe7, 0xa6, 0x57, 0x4b, 0xc2, 0x0f, 0x7c, 0x6b,
+	0xef, 0x93, 0x95, 0xf3, 0xd4, 0xfb, 0x29, 0x29, 0x9c, 0xd1, 0x41, 0x9f, 0x41, 0xf5, 0xcc, 0x67,
+	0x21, 0x83, 0x5b, 0xc3, 0xd6, 0x5e, 0x67, 0x55, 0xfb, 0x80, 0xf3, 0xb1, 0x94, 0x43, 0x7b, 0x50,
+	0x25, 0x97, 0x4b, 0x27, 0xb8, 0x92, 0x46, 0xd1, 0xd5, 0x45, 0x1c, 0xd5, 0x55, 0x1c, 0xd5, 0xa7,
+	0x2a, 0x8e, 0x62, 0x29, 0x89, 0x9e, 0x42, 0xdb, 0x9a, 0xcd, 0xc8, 0x92, 0x12, 0xbb, 0x1f, 0x05,
+	0x01, 0xf1, 0x66, 0x57, 0x3c, 0x78, 0x34, 0xf0, 0x0a, 0x8e, 0x9e, 0x40, 0x6b, 0x19, 0x38, 0x33,
+	0xc7, 0x9b, 0xc7, 0xa2, 0x55, 0x2e, 0x9a, 0x87, 0x51, 0x17, 0xea, 0xae, 0xe5, 0xcd, 0x23, 0x6b,
+	0x4e, 0x78, 0x8c, 0x68, 0xe0, 0x98, 0xd6, 0xc6, 0xd0, 0x4c, 0xaf, 0x1a, 0x6d, 0xc3, 0xe6, 0xf8,
+	0xf0, 0xab, 0x89, 0xd9, 0xef, 0x1d, 0x9d, 0xbc, 0x18, 0x8d, 0x8c, 0xf6, 0x2d, 0xd4, 0x86, 0xa6,
+	0x61, 0xbe, 0x30, 0xa7, 0x0a, 0x29, 0xa0, 0x0d, 0xa8, 0x4d, 0x06, 0xf8, 0x97, 0x66, 0x7f, 0xd0,
+	0x2e, 0xa2, 0x2d, 0x80, 0x3e, 0x1e, 0xfd, 0xca, 0x38, 0x39, 0x38, 0x1e, 0x1a, 0xed, 0x92, 0xf6,
+	0x18, 0xaa, 0x62, 0x27, 0x50, 0x0b, 0x36, 0x0e, 0xcc, 0x5f, 0x0f, 0x8c, 0x93, 0x31, 0x66, 0xa2,
+	0xb7, 0x98, 0x5e, 0xef, 0xb8, 0x3f, 0x35, 0x47, 0xc3, 0x76, 0xa1, 0xfb, 0x6f, 0x35, 0x28, 0x33,
+	0xf7, 0x40, 0x3b, 0x50, 0xa1, 0x0e, 0x75, 0x89, 0x74, 0x50, 0x41, 0xa0, 0x5d, 0xd8, 0xb0, 0x49,
+	0x38, 0x0b, 0x1c, 0x6e, 0xfb, 0xfc, 0xcc, 0x1a, 0x38, 0x0d, 0xa1, 0xc7, 0xb0, 0xb5, 0x0c, 0xfc,
+	0x19, 0x09, 0x43, 0xc7, 0x9b, 0xb3, 0xbd, 0xe4, 0x47, 0xd3, 0xc0, 0x39, 0x94, 0x8d, 0xcf, 0x76,
+	0x84, 0xf0, 0x73, 0x28, 0x63, 0x41, 0xb0, 0xa8, 0xe0, 0x85, 0x67, 0x6f, 0xf9, 0xf6, 0xd6, 0x31,
+	0x7f, 0x66, 0x18, 0xb5, 0xe6, 0xc2, 0xbd, 0x1a, 0x98, 0x3f, 0xa3, 0x9f, 0x40, 0xd5, 0x59, 0x58,
+	0x73, 0xa2, 0xdc, 0xe9, 0x76, 0xc6, 0xb7, 0x75, 0x93, 0xf1, 0xb0, 0x14, 0x61, 0x1e, 0x35, 0xb3,
+	0x28, 0x99, 0xfb, 0x81, 0x43, 0x62, 0x8f, 0x4a, 0x10, 0x36, 0x95, 0x79, 0x60, 0x2d, 0x84, 0x13,
+	0x15, 0xb1, 0x20, 0xd0, 0x3d, 0x68, 0xcc, 0x94, 0x17, 0x49, 0xa7, 0x49, 0x00, 0xa4, 0x43, 0xcd,
+	0x5f, 0x2a, 0x37, 0x61, 0x33, 0xd8, 0xc9, 0xce, 0x40, 0x06, 0x0b, 0x25, 0x84, 0x1e, 0x41, 0x39,
+	0x7c, 0x13, 0x85, 0x9d, 0xa6, 0xbc, 0xbd, 0x32, 0xc2, 0x93, 0x37, 0x11, 0xe6, 0x6c, 0xf4, 0x02,
+	0x5a, 0xdf, 0x44, 0x96, 0x47, 0x1d, 0x7a, 0xc5, 0x82, 0xaa, 0x6b, 0x5d, 0x75, 0x36, 0xb9, 0x65,
+	0xff, 0x38, 0xab, 0xf1, 0x65, 0x56, 0x08, 0xe7, 0xb5, 0xba, 0x7f, 0x5f, 0x80, 0xaa, 0x98, 0x03,
+	0xdf, 0x53, 0x6b, 0xa1, 0x0e, 0x92, 0x3f, 0xdf, 0xe0, 0x1c, 0x9f, 0x43, 0xfd, 0xc2, 0x0a, 0x1c,
+	0x8b, 0xc5, 0xf9, 0x12, 0x9f, 0xf4, 0xbd, 0x75, 0x2b, 0xd4, 0x7f, 0x29, 0x84, 0x70, 0x2c, 0xdd,
+	0x3d, 0x84, 0x9a, 0x04, 0xd7, 0xbe, 0xfa, 0x53, 0xa8, 0xf0, 0x73, 0x91, 0x11, 0x7e, 0xed, 0xc9,
+	0x09, 0x89, 0xee, 0x1f, 0x16, 0xa0, 0x34, 0x79, 0x13, 0xb1, 0x10, 0x26, 0x47, 0xef, 0xfb, 0x8b,
+	0x53, 0x9f, 0xa7, 0x2c, 0x9b, 0x38, 0x83, 0xb1, 0xe3, 0x5a, 0x06, 0xbe, 0x1d, 0xcd, 0xa8, 0xbc,
+	0x3c, 0x1a, 0x38, 0x01, 0x18, 0x37, 0x8c, 0x82, 0xd9, 0xb9, 0x15, 0xcc, 0x85, 0x41, 0x96, 0x70,
+	0x02, 0x30, 0x57, 0x54, 0xfb, 0xc7, 0xcd, 0xb1, 0x84, 0x63, 0xba, 0xfb, 0x5d, 0x01, 0x2a, 0x7c,
+	0x52, 0x4c, 0xea, 0xcc, 0x71, 0x49, 0x6a, 0x41, 0x31, 0xcd, 0x78, 0x7e, 0xe0, 0xcc, 0x1d, 0xcf,
+	0x72, 0xe5, 0xcb, 0x63, 0x9a, 0x99, 0x97, 0x1b, 0xbf, 0xb7, 0x81, 0x05, 0x81, 0xee, 0x40, 0x75,
+	0x41, 0x6c, 0x27, 0x12, 0xb7, 0x53, 0x03, 0x4b, 0x8a, 0x49, 0x87, 0x0b, 0xcb, 0x75, 0x65, 0x84,
+	0x11, 0x04, 0xf7, 0x01, 0xc7, 0x53, 0xb1, 0x84, 0x3f, 0x6b, 0xbf, 0x0d, 0xad, 0x9c, 0x19, 0x20,
+	0x80, 0xea, 0xa1, 0x69, 0x18, 0x83, 0x61, 0xfb, 0x16, 0x6a, 0x40, 0x65, 0xf0, 0xeb, 0x5e, 0x7f,
+	0x2a, 0x22, 0xc3, 0xfe, 0x68, 0x74, 0x34, 0xe8, 0x0d, 0xdb, 0xc5, 0xee, 0xdf, 0x54, 0x61, 0x2b,
+	0x7b, 0xa5, 0xad, 0x3d, 0xa6, 0xe7, 0x50, 0xa6, 0x49, 0x58, 0x7e, 0x78, 0xcd, 0x6d, 0x18, 0x93,
+	0x3c, 0x38, 0x73, 0x0d, 0xf4, 0x18, 0x6a, 0x01, 0x99, 0x73, 0xd7, 0x60, 0x86, 0xb3, 0xb5, 0xd7,
+	0xd4, 0xfb, 0x22, 0x7f, 0xed, 0xfb, 0x36, 0xc1, 0x8a, 0x89, 0x5e, 0xc1, 0xa6, 0xba, 0x4a, 0x71,
+	0xe4, 0x92, 0x50, 0x46, 0xe4, 0x47, 0xef, 0x7b, 0x15, 0x17, 0xc6, 0x59, 0x5d, 0xf4, 0x73, 0xa8,
+	0x87, 0x24, 0xb8, 0x70, 0x66, 0x44, 0x5d, 0xe0, 0xf7, 0xaf, 0x1d, 0x47, 0xc8, 0xe1, 0x58, 0xa1,
+	0x6b, 0x41, 0x4d, 0x82, 0x6b, 0xb7, 0x22, 0x0e, 0x55, 0xc5, 0x74, 0xa8, 0x7a, 0x06, 0xdb, 0x24,
+	0xa4, 0xce, 0xc2, 0xa2, 0xc4, 0x36, 0x88, 0xeb, 0x5c, 0x90, 0xe0, 0x4a, 0x1e, 0xf1, 0x2a, 0xa3,
+	0xfb, 0x6d, 0x09, 0x36, 0x33, 0x0b, 0x40, 0x2f, 0xa1, 0x1e, 0x44, 0x2e, 0xe1, 0x77, 0x5f, 0x81,
+	0x6f, 0xb2, 0x7e, 0xa3, 0x95, 0xeb, 0x58, 0x6a, 0xe1, 0x58, 0x1f, 0x7d, 0x01, 0x95, 0x80, 0x6f,
+	0x61, 0x91, 0x2f, 0xfd, 0xe9, 0xcd, 0x07, 0xc2, 0x42, 0xb1, 0x3b, 0x85, 0x32, 0x23, 0x99, 0x21,
+	0x2f, 0x1c, 0x0f, 0x5b, 0xde, 0x9c, 0xc8, 0x0b, 0x3b, 0xa6, 0x39, 0xcf, 0xba, 0x14, 0xbc, 0xa2,
+	0xe4, 0x49, 0x3a, 0xd9, 0xa3, 0x52, 0x6a, 0x8f, 0xb4, 0xbf, 0x28, 0x40, 0x5d, 0x4d, 0x17, 0x7d,
+	0x04, 0xdb, 0x5f, 0x1e, 0xf7, 0x86, 0x53, 0x73, 0xfa, 0xd5, 0x89, 0x61, 0x4e, 0xfa, 0xa3, 0xe3,
+	0xe1, 0xb4, 0x7d, 0x0b, 0xfd, 0x08, 0xee, 0x1e, 0x1c, 0xf5, 0xa6, 0x27, 0x07, 0x83, 0xc1, 0x49,
+	0xcc, 0xc7, 0xbd, 0xe1, 0x8b, 0x41, 0xbb, 0x80, 0x3e, 0x86, 0x8f, 0x62, 0xe6, 0xaf, 0x06, 0xe6,
+	0x8b, 0xc3, 0xa9, 0x64, 0x15, 0x19, 0xab, 0x3f, 0x7a, 0xbd, 0x6f, 0x0e, 0x07, 0xc6, 0xc9, 0xe4,
+	0xd0, 0x1c, 0x8f, 0xcd, 0xe1, 0x8b, 0x93, 0x9e, 0x61, 0xb4, 0x4b, 0xe8, 0x13, 0xe8, 0xae, 0xb2,
+	0x26, 0xc7, 0xfb, 0x53, 0xcc, 0xfc, 0xa1, 0xac, 0x7d, 0x0e, 0xcd, 0xb4, 0xdd, 0xb2, 0xbb, 0xf4,
+	0x68, 0xc4, 0xee, 0xd6, 0xb1, 0xd9, 0x7f, 0x75, 0x3c, 0x6e, 0xdf, 0xca, 0x5f, 0x92, 0x85, 0xee,
+	0x9f, 0x15, 0xa0, 0x34, 0xb5, 0x2e, 0x59, 0x3e, 0x43, 0xad, 0xcb, 0xf8, 0xd0, 0x1a, 0x58, 0x91,
+	0xe8, 0x19, 0x00, 0xb5, 0x2e, 0xb1, 0xb4, 0xfc, 0xe2, 0x1a, 0xcb, 0x4f, 0xf1, 0x59, 0x00, 0xa6,
+	0xd6, 0xa5, 0x9a, 0x05, 0xdf, 0xb5, 0x3a, 0x4e, 0x43, 0xec, 0xd6, 0x5a, 0x92, 0x60, 0x46, 0x3c,
+	0xca, 0x82, 0x65, 0x99, 0x5f, 0x4d, 0x29, 0x84, 0x47, 0x78, 0x91, 0x3b, 0x5e, 0x73, 0x57, 0xef,
+	0x40, 0xf9, 0xdc, 0x0a, 0xcf, 0x45, 0x3c, 0x3a, 0xbc, 0x85, 0x39, 0x85, 0x1e, 0x42, 0xd3, 0x76,
+	0x42, 0x5e, 0x50, 0xb2, 0x49, 0x09, 0x8b, 0x3d, 0xbc, 0x85, 0x33, 0x28, 0x7a, 0x0a, 0x2d, 0xf9,
+	0x2a, 0x43, 0xc2, 0x3c, 0x1e, 0x15, 0x0f, 0x0b, 0x38, 0xcf, 0x40, 0x8f, 0x61, 0x93, 0x9f, 0x76,
+	0x2c, 0xc9, 0x82, 0x54, 0xf9, 0xb0, 0x80, 0xb3, 0xf0, 0x7e, 0x15, 0xca, 0xac, 0x80, 0xdd, 0x07,
+	0xa8, 0xab, 0x77, 0x75, 0x29, 0xa0, 0xd5, 0xac, 0x92, 0x6d, 0xb2, 0x65, 0xdb, 0x01, 0x09, 0x43,
+	0xb5, 0xc9, 0x92, 0x44, 0x0f, 0x61, 0x33, 0x20, 0x21, 0xf5, 0x67, 0x6f, 0x1c, 0x6f, 0x7e, 0x40,
+	0x84, 0x1d, 0x16, 0x71, 0x16, 0xe4, 0x17, 0x7e, 0x92, 0x1a, 0x0b, 0x9f, 0x4c, 0x21, 0xda, 0x9f,
+	0x02, 0x54, 0x44, 0xd1, 0xca, 0xc7, 0x63, 0x89, 0x70, 0x2f, 0xf3, 0xbe, 0x2c, 0xc8, 0x6e, 0x0f,
+	0x01, 0xa8, 0x37, 0x96, 0x71, 0x02, 0xa0, 0x9f, 0x40, 0x3d, 0x4c, 0x9f, 0x23, 0x4b, 0xee, 0xf9,
+	0xe8, 0x89, 0xbb, 0xc5, 0x02, 0xe8, 0xc7, 0x50, 0xe3, 0xe5, 0xa5, 0x69, 0x74, 0xca, 0x49, 0x85,
+	0xa3, 0x30, 0xf4, 0x1c, 0x1a, 0x71, 0x1d, 0xdf, 0xa9, 0xbc, 0x37, 0x43, 0x4d, 0x84, 0xd1, 0x03,
+	0xa8, 0xb0, 0x82, 0x46, 0x55, 0x21, 0x1b, 0x72, 0x0a, 0xbc, 0xd4, 0x11, 0x1c, 0xf4, 0x04, 0x6a,
+	0x4b, 0xeb, 0x8a, 0x17, 0xd1, 0xa2, 0x28, 0xdd, 0x92, 0x42, 0x63, 0x81, 0x62, 0xc5, 0x66, 0x1b,
+	0x18, 0x58, 0x2c, 0x80, 0xbc, 0x22, 0x57, 0x22, 0x63, 0x6a, 0xe2, 0x14, 0x82, 0xf6, 0x60, 0xc7,
+	0x72, 0x29, 0x09, 0x3c, 0x8b, 0x12, 0x96, 0xa8, 0x5a, 0x33, 0x6a, 0x7a, 0x67, 0xbe, 0xac, 0x42,
+	0xd6, 0xf2, 0xba, 0xff, 0x5c, 0x80, 0x7a, 0x6c, 0xdc, 0x77, 0xa0, 0xca, 0xb6, 0x64, 0xea, 0xcb,
+	0x0d, 0x97, 0x54, 0xfa, 0xe4, 0x8b, 0xd9, 0x93, 0x47, 0x50, 0x9e, 0xb1, 0xfb, 0x59, 0x9c, 0x26,
+	0x7f, 0xe6, 0x77, 0x25, 0xb5, 0x28, 0x91, 0x57, 0xa8, 0x20, 0xb8, 0xe3, 0xf8, 0x21, 0xb5, 0x5c,
+	0x6e, 0xdf, 0xe2, 0x1a, 0x4d, 0x21, 0xec, 0x7e, 0x92, 0xfd, 0x14, 0x6e, 0xa9, 0x2b, 0xf7, 0x93,
+	0x64, 0xb2, 0xac, 0x43, 0xbe, 0x7c, 0xe8, 0x53, 0x9e, 0x69, 0xf2, 0xc2, 0x29, 0x8d, 0x75, 0xff,
+	0xbd, 0x28, 0xd3, 0xe5, 0x5d, 0xd8, 0x70, 0x45, 0xcc, 0x3d, 0x64, 0x3e, 0x27, 0x56, 0x95, 0x86,
+	0x32, 0x49, 0x86, 0x8c, 0x9e, 0x8a, 0x46, 0xcf, 0x92, 0x6c, 0x52, 0xe4, 0x5a, 0x28, 0x75, 0x7c,
+	0x2b, 0xb9, 0xe4, 0x3e, 0x6c, 0x65, 0x6b, 0xd0, 0xb8, 0x96, 0x49, 0x29, 0xe5, 0xaa, 0xd6, 0x9c,
+	0x06, 0xdb, 0xce, 0x05, 0x59, 0xf8, 0x72, 0x7b, 0xf8, 0x33, 0x5b, 0x83, 0x28, 0x42, 0xd9, 0x3e,
+	0xa8, 0x7c, 0x3b, 0x0d, 0x75, 0xf7, 0xde, 0x99, 0x54, 0xee, 0x40, 0xe5, 0xc2, 0x72, 0x23, 0x22,
+	0x8f, 0x4e, 0x10, 0xdd, 0xdf, 0xbb, 0x51, 0xba, 0xd1, 0x81, 0x9a, 0xbc, 0x8e, 0xd5, 0xc1, 0x4b,
+	0xb2, 0xfb, 0xaf, 0x45, 0xa8, 0x49, 0x03, 0x45, 0x3f, 0x65, 0x49, 0x13, 0x3d, 0xf7, 0x6d, 0x79,
+	0x63, 0x7e, 0x94, 0x35, 0x60, 0x56, 0xf5, 0x9d, 0xfb, 0x36, 0x96, 0x42, 0xcc, 0x6f, 0xe3, 0xc2,
+	0x59, 0xe5, 0x84, 0x31, 0xc0, 0x6c, 0xd0, 0x5a, 0xf0, 0x80, 0x25, 0xee, 0x2c, 0x49, 0x31, 0xad,
+	0xd9, 0xb9, 0xe5, 0x78, 0x2c, 0x58, 0x49, 0xcb, 0x4a, 0x80, 0xb4, 0x85, 0x56, 0xb2, 0x16, 0xca,
+	0x0b, 0x6d, 0x9b, 0x90, 0xc5, 0x84, 0x27, 0xd1, 0x32, 0x57, 0xcb, 0x60, 0xac, 0x3a, 0x8a, 0x27,
+	0x30, 0xb6, 0x3c, 0xe2, 0xf2, 0xfa, 0xa5, 0x81, 0x73, 0x28, 0x2b, 0xf2, 0x63, 0x64, 0x7a, 0x1e,
+	0x90, 0xf0, 0xdc, 0x77, 0x45, 0x4f, 0x68, 0x13, 0xaf, 0xe1, 0x68, 0xcf, 0xa1, 0x2a, 0xd6, 0x8e,
+	0x6e, 0x43, 0xab, 0x67, 0x18, 0x78, 0x30, 0x99, 0x9c, 0xe0, 0xc1, 0x97, 0xc7, 0x83, 0x09, 0xbb,
+	0x63, 0x01, 0xaa, 0x86, 0x89, 0x07, 0x3c, 0x19, 0xdc, 0x84, 0xc6, 0xeb, 0x91, 0x31, 0xc0, 0xbd,
+	0xe9, 0xc0, 0x68, 0x17, 0xb5, 0xef, 0x8b, 0xb0, 0xbd, 0xda, 0x7e, 0xeb, 0x40, 0xcd, 0x67, 0xa0,
+	0x69, 0xa8, 0x08, 0x2c, 0xc9, 0x6c, 0x84, 0x2a, 0x7e, 0x48, 0x84, 0x62, 0x95, 0xa1, 0x38, 0x27,
+	0x15, 0x6c, 0x55, 0x65, 0x98, 0x41, 0x59, 0x09, 0x1d, 0x90, 0x6f, 0x22, 0x12, 0x52, 0x62, 0xf7,
+	0xc4, 0x01, 0x89, 0x1a, 0x31, 0x0f, 0xa3, 0xdf, 0x85, 0xb6, 0x08, 0x4a, 0x93, 0xa4, 0x25, 0x56,
+	0x91, 0xad, 0x2d, 0x9c, 0x65, 0xe0, 0x15, 0x49, 0x34, 0x84, 0x4e, 0xf6, 0xcd, 0x06, 0x09, 0x9c,
+	0x0b, 0xd1, 0xb1, 0xac, 0xca, 0xa6, 0xdd, 0x0a, 0x07, 0x5f, 0xab, 0x83, 0x7e, 0x43, 0x45, 0x60,
+	0x51, 0x92, 0x6e, 0x72, 0x3f, 0x9c, 0x78, 0xd6, 0x32, 0x3c, 0xf7, 0xa9, 0x8c, 0xc1, 0xda, 0xa7,
+	0xb0, 0xbd, 0xaa, 0xb9, 0x03, 0x15, 0xc7, 0xb3, 0xc9, 0xa5, 0xcc, 0xb8, 0x04, 0xa1, 0x7d, 0x5b,
+	0x84, 0x66, 0x7a, 0x88, 0x1b, 0xc4, 0x18, 0xd5, 0x54, 0x2b, 0xa6, 0x9a, 0x6a, 0x71, 0x72, 0x50,
+	0x4a, 0x27, 0x07, 0xf7, 0xa0, 0x41, 0xcf, 0xa3, 0xc5, 0xa9, 0x67, 0x39, 0xae, 0x32, 0xf2, 0x18,
+	0x58, 0xd7, 0xc5, 0xa8, 0xac, 0xef, 0x62, 0xdc, 0x83, 0x46, 0xe4, 0x39, 0x74, 0xcc, 0x73, 0xbf,
+	0xaa, 0x28, 0xac, 0x62, 0x20, 0x13, 0xf3, 0x6a, 0xd7, 0xc7, 0xbc, 0xfa, 0x7b, 0x63, 0x9e, 0xf6,
+	0x9f, 0x05, 0x68, 0xe5, 0xda, 0x92, 0x3f, 0x98, 0x91, 0x06, 0xe4, 0xc2, 0xf1, 0xa3, 0xb0, 0x97,
+	0x0e, 0x0e, 0x39, 0x94, 0xad, 0xdb, 0x23, 0x6f, 0x33, 0xe6, 0x99, 0x00, 0x6b, 0x4c, 0xbd, 0xb2,
+	0xd6, 0xd4, 0xef, 0xb0, 0x76, 0xaf, 0x15, 0x4a, 0x83, 0x6b, 0x60, 0x49, 0x69, 0x7f, 0x52, 0x80,
+	0x0d, 0xbe, 0x5a, 0x4c, 0xbe, 0x26, 0xb3, 0x1f, 0x66, 0xa5, 0xac, 0x23, 0xe1, 0xcc, 0xd5, 0x85,
+	0xb3, 0xad, 0xef, 0x3b, 0x74, 0xe6, 0x3b, 0x5e, 0xe2, 0x31, 0x9c, 0xad, 0x7d, 0x5f, 0x80, 0x56,
+	0xce, 0x97, 0xd0, 0x17, 0xa9, 0x36, 0x6c, 0x81, 0xbf, 0xf3, 0x61, 0xde, 0xdf, 0xf4, 0x69, 0x60,
+	0x79, 0xa1, 0xc5, 0x13, 0xba, 0x35, 0x9d, 0x59, 0x56, 0x8f, 0x2b, 0x51, 0x3e, 0xed, 0x26, 0x4e,
+	0x80, 0xee, 0x15, 0xdc, 0x5e, 0xa3, 0x9e, 0xb2, 0xff, 0x49, 0xd2, 0x39, 0x4e, 0x43, 0x6c, 0xd8,
+	0x38, 0x4b, 0x51, 0xc3, 0xc6, 0x00, 0x0b, 0xd0, 0x71, 0xe8, 0x64, 0x02, 0x25, 0x2e, 0x90, 0xc1,
+	0xb4, 0x31, 0xb4, 0xf3, 0x1b, 0xc1, 0x12, 0x0a, 0xc7, 0x5b, 0x46, 0xd4, 0x4c, 0xf9, 0x68, 0x0a,
+	0x79, 0xf7, 0x62, 0xb4, 0x3f, 0xaa, 0x42, 0x7b, 0xe5, 0x9b, 0x44, 0x7c, 0xa0, 0x76, 0xf6, 0x40,
+	0xed, 0xb5, 0x2e, 0x9c, 0x39, 0xe4, 0xd2, 0x87, 0x1c, 0xf2, 0x10, 0xda, 0xcb, 0xf3, 0xab, 0xd0,
+	0x99, 0x59, 0x6e, 0x5c, 0xa3, 0x8a, 0x0f, 0x28, 0xda, 0xca, 0x07, 0x14, 0x7d, 0x9c, 0x93, 0xc4,
+	0x2b, 0xba, 0xe8, 0x15, 0xb4, 0x6c, 0x67, 0xee, 0xd0, 0xd4, 0x70, 0x22, 0xe0, 0x3e, 0x58, 0x1d,
+	0xce, 0xc8, 0x0a, 0xe2, 0xbc, 0x26, 0xeb, 0xde, 0x2e, 0xad, 0x2b, 0x3f, 0xa2, 0x32, 0xdc, 0x76,
+	0xd6, 0x4c, 0x89, 0xf3, 0xb1, 0x94, 0x43, 0xbf, 0x03, 0xad, 0x5c, 0x18, 0x97, 0x99, 0xec, 0x6a,
+	0xbc, 0xcf, 0x0b, 0xf2, 0xac, 0xc3, 0xa7, 0xa4, 0x53, 0x97, 0x59, 0x87, 0x4f, 0x49, 0x77, 0x0a,
+	0xed, 0xfc, 0xa2, 0x79, 0x26, 0xc2, 0xf2, 0x15, 0x12, 0xa8, 0xa3, 0x91, 0x24, 0xf3, 0x6a, 0xd6,
+	0x92, 0x65, 0x55, 0xc6, 0x30, 0x5a, 0x9c, 0x12, 0x95, 0x53, 0xe4, 0xd0, 0xee, 0x2f, 0xa0, 0x95,
+	0x5b, 0x3b, 0x6a, 0x43, 0x29, 0x0a, 0x5c, 0x39, 0x20, 0x7b, 0x64, 0xa1, 0x71, 0x69, 0x85, 0xe1,
+	0x5b, 0x3f, 0xb0, 0x55, 0xc7, 0x48, 0xd1, 0xdd, 0x7f, 0x2a, 0x40, 0x55, 0xac, 0x3c, 0xf6, 0xd2,
+	0xc2, 0x3b, 0xbd, 0x94, 0xd5, 0x31, 0x62, 0x8b, 0x7a, 0x99, 0xec, 0x39, 0x0b, 0xb2, 0x46, 0xb6,
+	0x00, 0x0e, 0x08, 0x19, 0x93, 0x60, 0xff, 0x8a, 0xaa, 0x7a, 0x7d, 0x05, 0x67, 0x9f, 0xf3, 0x32,
+	0xca, 0xa9, 0xcb, 0xb1, 0x7c, 0xed, 0xe5, 0x78, 0x9d, 0x8a, 0xf6, 0x77, 0x2a, 0x7c, 0xa7, 0xbe,
+	0xa8, 0x5d, 0xef, 0x03, 0xff, 0xfb, 0xa0, 0xf6, 0x39, 0x80, 0x98, 0xc2, 0xe4, 0x9d, 0xa1, 0x2d,
+	0x25, 0x84, 0x1e, 0x40, 0x4d, 0x98, 0x4a, 0x28, 0x3d, 0xa3, 0x26, 0x6d, 0x09, 0x2b, 0x5c, 0xfb,
+	0xef, 0x32, 0x54, 0x05, 0x86, 0xf6, 0x54, 0x65, 0x64, 0x24, 0xc1, 0x0f, 0x49, 0x05, 0x1d, 0xc7,
+	0x1c, 0x9c, 0x92, 0x7a, 0x4f, 0xb0, 0xfb, 0xae, 0x0c, 0x80, 0x33, 0xc2, 0x49, 0x08, 0x2b, 0xe4,
+	0x43, 0xd8, 0x7b, 0xbf, 0x90, 0xe9, 0xd0, 0x10, 0xcf, 0x13, 0x47, 0x55, 0xa3, 0xab, 0xbe, 0x91,
+	0x88, 0xbc, 0xaf, 0x1e, 0xbd, 0x07, 0x0d, 0xfe, 0x38, 0x64, 0xf9, 0xba, 0xb8, 0xc3, 0x12, 0x80,
+	0xd9, 0x30, 0x27, 0xd8, 0xbb, 0xaa, 0x7c, 0xaa, 0x31, 0x8d, 0x1e, 0xc1, 0x46, 0x1c, 0x58, 0x4d,
+	0xa3, 0x53, 0x4b, 0x06, 0x4f, 0xe3, 0x99, 0x98, 0xcc, 0x86, 0xa9, 0xe7, 0x62, 0x32, 0x1b, 0x2a,
+	0x63, 0x0e, 0x8d, 0x0f, 0x31, 0x07, 0x66, 0x62, 0x17, 0x24, 0x60, 0xed, 0x54, 0x10, 0x5f, 0x9f,
+	0x24, 0xc9, 0x38, 0xdf, 0x44, 0x96, 0xcb, 0x12, 0x93, 0x0d, 0xc1, 0x91, 0x64, 0xbe, 0x35, 0xde,
+	0xe4, 0xdc, 0x34, 0xc4, 0x9c, 0xcd, 0x96, 0x8e, 0x3d, 0x59, 0x12, 0x62, 0xf3, 0x16, 0xfd, 0x26,
+	0xce, 0x82, 0x2c, 0x87, 0x9a, 0x45, 0x21, 0xf5, 0x17, 0x24, 0x90, 0xcd, 0xc5, 0xce, 0x16, 0x97,
+	0xcb, 0xc3, 0x22, 0x0b, 0xb8, 0x70, 0xc8, 0xdb, 0x4e, 0x4b, 0x65, 0x01, 0x8c, 0xd2, 0xfe, 0xa5,
+	0x00, 0x35, 0xf9, 0x69, 0x35, 0xbb, 0x07, 0x85, 0x0f, 0xd9, 0x83, 0x1d, 0xa8, 0xcc, 0x5c, 0xcb,
+	0x59, 0xa8, 0xaa, 0x8c, 0x13, 0xab, 0x01, 0xa3, 0xb4, 0x2e, 0x60, 0xfc, 0x26, 0x34, 0xfc, 0x88,
+	0x2e, 0x7d, 0xc7, 0xa3, 0xca, 0x3b, 0x1a, 0xfa, 0x48, 0x22, 0x38, 0xe1, 0xb1, 0x7a, 0x25, 0x24,
+	0x81, 0x63, 0xb9, 0xce, 0x1f, 0x10, 0x5b, 0x7d, 0xba, 0xe2, 0x06, 0xd3, 0xc4, 0x6b, 0x38, 0xda,
+	0x7f, 0x95, 0x61, 0x7b, 0xe5, 0x83, 0xf8, 0xff, 0x61, 0x91, 0xa9, 0x58, 0x52, 0xcc, 0xc6, 0x12,
+	0xd6, 0x0d, 0x08, 0xfc, 0xa5, 0x1f, 0x12, 0x7b, 0x5f, 0x75, 0x0f, 0x52, 0x08, 0xe3, 0x07, 0xf1,
+	0x0c, 0x64, 0x26, 0x9c, 0x42, 0xd0, 0xe7, 0xf1, 0x25, 0x25, 0xda, 0x31, 0x1f, 0xaf, 0x7e, 0xc8,
+	0xcf, 0xdf, 0x52, 0x9f, 0xc1, 0xed, 0xd8, 0x7e, 0x63, 0xd7, 0x13, 0xf5, 0x74, 0x13, 0xaf, 0x63,
+	0x75, 0xff, 0xa3, 0xf8, 0xa1, 0x01, 0xff, 0x01, 0x54, 0x79, 0x06, 0xa2, 0x5a, 0xbe, 0xa9, 0x63,
+	0x91, 0x0c, 0xb4, 0x0f, 0x1b, 0xe2, 0x4f, 0x86, 0x88, 0x2e, 0x23, 0x2a, 0x83, 0xc1, 0xee, 0xb5,
+	0xd3, 0xd7, 0x85, 0x1c, 0x4e, 0x2b, 0x21, 0x03, 0x9a, 0xf2, 0x97, 0x00, 0x31, 0x48, 0xf9, 0x86,
+	0x83, 0x64, 0xb4, 0xd0, 0x4b, 0x68, 0xc5, 0xab, 0x96, 0x03, 0x55, 0x6e, 0x38, 0x50, 0x5e, 0xb1,
+	0xfb, 0x1c, 0xaa, 0x72, 0x54, 0xd6, 0x43, 0x12, 0x95, 0xb6, 0xea, 0x21, 0x71, 0x2a, 0x55, 0xd7,
+	0x17, 0xd3, 0x75, 0xbd, 0xf6, 0xd7, 0x05, 0x40, 0xab, 0x7f, 0x2a, 0xbc, 0xe3, 0x1a, 0xda, 0xcd,
+	0x86, 0x30, 0xf9, 0x91, 0x2c, 0x05, 0xdd, 0x30, 0x87, 0xce, 0xda, 0x75, 0xf9, 0x03, 0xec, 0x5a,
+	0x7b, 0x09, 0x75, 0x75, 0xae, 0x2c, 0x81, 0x39, 0x4f, 0xea, 0x3e, 0xfe, 0x9c, 0x54, 0x8e, 0xc5,
+	0x54, 0xe5, 0x98, 0x34, 0x62, 0x64, 0x33, 0x9e, 0x13, 0xda, 0x5f, 0x16, 0xa1, 0x2a, 0xfe, 0x26,
+	0xf9, 0x7f, 0xac, 0x27, 0xd0, 0x00, 0xb6, 0x45, 0xeb, 0x34, 0x95, 0xe1, 0xcb, 0x3d, 0xb9, 0x2b,
+	0x7f, 0x76, 0x49, 0xd7, 0x0e, 0xac, 0x75, 0x88, 0x57, 0x35, 0xd6, 0xf5, 0xaf, 0xba, 0x3f, 0x87,
+	0x56, 0x4e, 0x93, 0x89, 0xd1, 0x4b, 0x47, 0x9d, 0x2c, 0x7f, 0xce, 0xb6, 0xa9, 0xe2, 0xdd, 0xf9,
+	0xc7, 0x02, 0x14, 0x4d, 0x83, 0x19, 0xcf, 0x92, 0xa4, 0x36, 0x46, 0x52, 0xec, 0x9e, 0x3a, 0x75,
+	0xfd, 0xd9, 0x1b, 0xde, 0x08, 0x8a, 0x8d, 0x21, 0x83, 0xa1, 0x47, 0x50, 0x5b, 0x46, 0xa7, 0x6f,
+	0x58, 0xcb, 0x54, 0x38, 0xdb, 0x86, 0x6e, 0x1a, 0xfa, 0x58, 0x40, 0x58, 0xf1, 0x58, 0xc4, 0x39,
+	0x8d, 0xf7, 0x86, 0x2f, 0xbd, 0x89, 0x53, 0x48, 0xf7, 0x17, 0x50, 0x93, 0x3a, 0xec, 0x82, 0x75,
+	0x6c, 0x22, 0xea, 0x67, 0x91, 0x0b, 0xc4, 0x34, 0x3b, 0x43, 0xa9, 0x24, 0x73, 0x0a, 0x45, 0x6a,
+	0x7f, 0x55, 0x84, 0x46, 0x92, 0xf7, 0x3e, 0x63, 0x9d, 0x35, 0xb1, 0xcd, 0xa2, 0x69, 0x86, 0x92,
+	0xdf, 0x85, 0xf4, 0x89, 0xe0, 0x60, 0x25, 0xc2, 0x72, 0xdc, 0x38, 0x35, 0x61, 0x79, 0x60, 0x28,
+	0x07, 0xcf, 0xa1, 0xda, 0x3f, 0x14, 0xd8, 0x37, 0x33, 0xa1, 0xb3, 0x01, 0xb5, 0x23, 0x73, 0x32,
+	0x35, 0x87, 0x2f, 0xc4, 0x67, 0xc7, 0x11, 0x36, 0x06, 0xb8, 0x5d, 0x40, 0x77, 0x00, 0xf1, 0xc7,
+	0x93, 0xfe, 0x68, 0x78, 0x60, 0xe2, 0xd7, 0x3d, 0xfe, 0x8f, 0x41, 0x91, 0x7d, 0x08, 0x12, 0xf8,
+	0xc1, 0xf1, 0xd1, 0x81, 0x79, 0x74, 0xf4, 0x7a, 0x30, 0x9c, 0xb6, 0x4b, 0x68, 0x07, 0xda, 0x4a,
+	0xfc, 0xf5, 0xf8, 0x68, 0xc0, 0x85, 0xcb, 0x6c, 0x70, 0xc3, 0x9c, 0x8c, 0x8f, 0xa7, 0x83, 0x76,
+	0x85, 0x8d, 0x28, 0x89, 0x13, 0x3c, 0x98, 0x8c, 0x8e, 0x8e, 0xb9, 0x50, 0x95, 0xf5, 0xb7, 0xf0,
+	0x80, 0xff, 0xe9, 0x50, 0x4b, 0x86, 0xe9, 0x19, 0x2f, 0x8f, 0x27, 0x53, 0x3e, 0x78, 0x1d, 0xdd,
+	0x85, 0xdb, 0x4a, 0x73, 0x30, 0x34, 0x46, 0x78, 0x32, 0xe0, 0x8c, 0x86, 0x46, 0x60, 0x93, 0x6d,
+	0x07, 0xb1, 0xd5, 0x8f, 0x49, 0x1a, 0xd4, 0x64, 0x49, 0x29, 0xaf, 0xa0, 0xe4, 0xd7, 0x38, 0xc5,
+	0x88, 0x5d, 0xb1, 0x98, 0x72, 0xc5, 0x4c, 0x96, 0x57, 0xca, 0x65, 0x79, 0xfb, 0xe5, 0xdf, 0x2f,
+	0x2e, 0x4f, 0x4f, 0xab, 0xdc, 0x85, 0x7e, 0xeb, 0x7f, 0x06, 0x00, 0xec, 0x94, 0x0f, 0xab, 0xe2,
+	0x27, 0x00, 0x00,
 }
@@ -18,25 +18,27 @@ var _ = math.Inf
 type Message_MessageType int32
 
 const (
-	Message_PING               Message_MessageType = 0
-	Message_CHAT               Message_MessageType = 1
-	Message_FOLLOW             Message_MessageType = 2
-	Message_UNFOLLOW           Message_MessageType = 3
-	Message_ORDER              Message_MessageType = 4
-	Message_ORDER_REJECT       Message_MessageType = 5
-	Message_ORDER_CANCEL       Message_MessageType = 6
-	Message_ORDER_CONFIRMATION Message_MessageType = 7
-	Message_ORDER_FULFILLMENT  Message_MessageType = 8
-	Message_ORDER_COMPLETION   Message_MessageType = 9
-	Message_DISPUTE_OPEN       Message_MessageType = 10
-	Message_DISPUTE_UPDATE     Message_MessageType = 11
-	Message_DISPUTE_CLOSE      Message_MessageType = 12
-	Message_REFUND             Message_MessageType = 13
-	Message_OFFLINE_ACK        Message_MessageType = 14
-	Message_OFFLINE_RELAY      Message_MessageType = 15
-	Message_MODERATOR_ADD      Message_MessageType = 16
-	Message_MODERATOR_REMOVE   Message_MessageType = 17
-	Message_ERROR              Message_MessageType = 500
+	Message_PING                Message_MessageType = 0
+	Message_CHAT                Message_MessageType = 1
+	Message_FOLLOW              Message_MessageType = 2
+	Message_UNFOLLOW            Message_MessageType = 3
+	Message_ORDER               Message_MessageType = 4
+	Message_ORDER_REJECT        Message_MessageType = 5
+	Message_ORDER_CANCEL        Message_MessageType = 6
+	Message_ORDER_CONFIRMATION  Message_MessageType = 7
+	Message_ORDER_FULFILLMENT   Message_MessageType = 8
+	Message_ORDER_COMPLETION    Message_MessageType = 9
+	Message_DISPUTE_OPEN        Message_MessageType = 10
+	Message_DISPUTE_UPDATE      Message_MessageType = 11
+	Message_DISPUTE_CLOSE       Message_MessageType = 12
+	Message_REFUND              Message_MessageType = 13
+	Message_OFFLINE_ACK         Message_MessageType = 14
+	Message_OFFLINE_RELAY       Message_MessageType = 15
+	Message_MODERATOR_ADD       Message_MessageType = 16
+	Message_MODERATOR_REMOVE    Message_MessageType = 17
+	Message_ORDER_ADJUSTMENT    Message_MessageType = 18
+	Message_DISPUTE_ENDORSEMENT Message_MessageType = 19
+	Message_ERROR               Message_MessageType = 500
 )
 
 var Message_MessageType_name = map[int32]string{
@@ -58,28 +60,32 @@ var Message_MessageType_name = map[int32]string{
 	15:  "OFFLINE_RELAY",
 	16:  "MODERATOR_ADD",
 	17:  "MODERATOR_REMOVE",
+	18:  "ORDER_ADJUSTMENT",
+	19:  "DISPUTE_ENDORSEMENT",
 	500: "ERROR",
 }
 var Message_MessageType_value = map[string]int32{
-	"PING":               0,
-	"CHAT":               1,
-	"FOLLOW":             2,
-	"UNFOLLOW":           3,
-	"ORDER":              4,
-	"ORDER_REJECT":       5,
-	"ORDER_CANCEL":       6,
-	"ORDER_CONFIRMATION": 7,
-	"ORDER_FULFILLMENT":  8,
-	"ORDER_COMPLETION":   9,
-	"DISPUTE_OPEN":       10,
-	"DISPUTE_UPDATE":     11,
-	"DISPUTE_CLOSE":      12,
-	"REFUND":             13,
-	"OFFLINE_ACK":        14,
-	"OFFLINE_RELAY":      15,
-	"MODERATOR_ADD":      16,
-	"MODERATOR_REMOVE":   17,
-	"ERROR":              500,
+	"PING":                0,
+	"CHAT":                1,
+	"FOLLOW":              2,
+	"UNFOLLOW":            3,
+	"ORDER":               4,
+	"ORDER_REJECT":        5,
+	"ORDER_CANCEL":        6,
+	"ORDER_CONFIRMATION":  7,
+	"ORDER_FULFILLMENT":   8,
+	"ORDER_COMPLETION":    9,
+	"DISPUTE_OPEN":        10,
+	"DISPUTE_UPDATE":      11,
+	"DISPUTE_CLOSE":       12,
+	"REFUND":              13,
+	"OFFLINE_ACK":         14,
+	"OFFLINE_RELAY":       15,
+	"MODERATOR_ADD":       16,
+	"MODERATOR_REMOVE":    17,
+	"ORDER_ADJUSTMENT":    18,
+	"DISPUTE_ENDORSEMENT": 19,
+	"ERROR":               500,
 }
 
 func (x Message_MessageType) String() string {
@@ -242,41 +248,43 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc6, 0xeb, 0xc4, 0xf9, 0x37, 0x4e, 0xdb, 0xed, 0xaa, 0x6f, 0x95, 0xb7, 0x42, 0x25, 0xca,
-	0x29, 0x5c, 0x5c, 0x29, 0x48, 0x88, 0xab, 0xb1, 0xd7, 0xc5, 0x60, 0x7b, 0xa3, 0x89, 0x03, 0x2a,
-	0x97, 0xc8, 0x21, 0xdb, 0x50, 0x48, 0x63, 0x53, 0x3b, 0x48, 0xf9, 0x90, 0x7c, 0x04, 0xbe, 0x05,
-	0x77, 0xd0, 0x6e, 0xbc, 0x24, 0x82, 0x93, 0x77, 0x7e, 0xcf, 0xa3, 0x99, 0xb1, 0x9e, 0x81, 0xe3,
-	0x07, 0x51, 0x14, 0xe9, 0x52, 0xd8, 0xf9, 0x63, 0x56, 0x66, 0x97, 0xff, 0x2f, 0xb3, 0x6c, 0xb9,
-	0x12, 0xd7, 0xaa, 0x9a, 0x6f, 0xee, 0xae, 0xd3, 0xf5, 0xb6, 0x92, 0x9e, 0xfe, 0x2d, 0x95, 0xf7,
-	0x0f, 0xa2, 0x28, 0xd3, 0x87, 0x7c, 0x67, 0x18, 0xfc, 0xaa, 0x43, 0x2b, 0xda, 0x75, 0xa3, 0x2f,
-	0xc0, 0xaa, 0x1a, 0x27, 0xdb, 0x5c, 0xf4, 0x8c, 0xbe, 0x31, 0x3c, 0x19, 0x9d, 0xdb, 0x95, 0xac,
-	0xbf, 0x52, 0xc3, 0x43, 0x23, 0xb5, 0xa1, 0x95, 0xa7, 0xdb, 0x55, 0x96, 0x2e, 0x7a, 0xb5, 0xbe,
-	0x31, 0xb4, 0x46, 0xe7, 0xf6, 0x6e, 0xac, 0xad, 0xc7, 0xda, 0xce, 0x7a, 0x8b, 0xda, 0x44, 0x9f,
-	0x40, 0xe7, 0x51, 0x7c, 0xdd, 0x88, 0xa2, 0x0c, 0x16, 0xbd, 0x7a, 0xdf, 0x18, 0x36, 0x70, 0x0f,
-	0xe8, 0x15, 0xc0, 0x7d, 0x81, 0xa2, 0xc8, 0xb3, 0x75, 0x21, 0x7a, 0x66, 0xdf, 0x18, 0xb6, 0xf1,
-	0x80, 0x0c, 0xbe, 0xd7, 0xc0, 0x3a, 0x58, 0x85, 0xb6, 0xc1, 0x1c, 0x07, 0xf1, 0x0d, 0x39, 0x92,
-	0x2f, 0xf7, 0xb5, 0x93, 0x10, 0x83, 0x02, 0x34, 0x7d, 0x1e, 0x86, 0xfc, 0x3d, 0xa9, 0xd1, 0x2e,
-	0xb4, 0xa7, 0x71, 0x55, 0xd5, 0x69, 0x07, 0x1a, 0x1c, 0x3d, 0x86, 0xc4, 0xa4, 0x04, 0xba, 0xea,
-	0x39, 0x43, 0xf6, 0x86, 0xb9, 0x09, 0x69, 0xec, 0x89, 0xeb, 0xc4, 0x2e, 0x0b, 0x49, 0x93, 0x5e,
-	0x00, 0xad, 0x08, 0x8f, 0xfd, 0x00, 0x23, 0x27, 0x09, 0x78, 0x4c, 0x5a, 0xf4, 0x3f, 0x38, 0xdb,
-	0x71, 0x7f, 0x1a, 0xfa, 0x41, 0x18, 0x46, 0x2c, 0x4e, 0x48, 0x9b, 0x9e, 0x03, 0xd1, 0xf6, 0x68,
-	0x1c, 0x32, 0x65, 0xee, 0xc8, 0xb6, 0x5e, 0x30, 0x19, 0x4f, 0x13, 0x36, 0xe3, 0x63, 0x16, 0x13,
-	0xa0, 0x14, 0x4e, 0x34, 0x99, 0x8e, 0x3d, 0x27, 0x61, 0xc4, 0xa2, 0x67, 0x70, 0xac, 0x99, 0x1b,
-	0xf2, 0x09, 0x23, 0x5d, 0xf9, 0x1b, 0xc8, 0xfc, 0x69, 0xec, 0x91, 0x63, 0x7a, 0x0a, 0x16, 0xf7,
-	0xfd, 0x30, 0x88, 0xd9, 0xcc, 0x71, 0xdf, 0x92, 0x13, 0xe9, 0xd7, 0x00, 0x59, 0xe8, 0xdc, 0x92,
-	0x53, 0x89, 0x22, 0xee, 0x31, 0x74, 0x12, 0x8e, 0x33, 0xc7, 0xf3, 0x08, 0x91, 0x1b, 0xed, 0x11,
-	0xb2, 0x88, 0xbf, 0x63, 0xe4, 0x8c, 0x02, 0x34, 0x18, 0x22, 0x47, 0xf2, 0xb3, 0x3e, 0x58, 0x40,
-	0x9b, 0xad, 0xbf, 0x89, 0x55, 0x96, 0x0b, 0x3a, 0x80, 0x56, 0x15, 0xac, 0x4a, 0xdf, 0x1a, 0xb5,
-	0x75, 0xea, 0xa8, 0x05, 0x7a, 0x01, 0xcd, 0x7c, 0x33, 0xff, 0x22, 0xb6, 0x2a, 0xec, 0x2e, 0x56,
-	0x95, 0x4c, 0xb5, 0xb8, 0x5f, 0xae, 0xd3, 0x72, 0xf3, 0x28, 0x54, 0xaa, 0x5d, 0xdc, 0x83, 0xc1,
-	0x0f, 0x03, 0x4c, 0xf7, 0x53, 0x5a, 0x4a, 0x5b, 0xd5, 0x29, 0x58, 0xa8, 0x21, 0x1d, 0xdc, 0x03,
-	0xda, 0x83, 0x56, 0xb1, 0x99, 0x7f, 0x16, 0x1f, 0x4b, 0xd5, 0xbd, 0x83, 0xba, 0x94, 0x8a, 0x5e,
-	0xad, 0xbe, 0x53, 0xf4, 0x42, 0x2f, 0xa1, 0xf3, 0xe7, 0xaa, 0xd5, 0xbd, 0x58, 0xa3, 0xcb, 0x7f,
-	0x0e, 0x30, 0xd1, 0x0e, 0xdc, 0x9b, 0xe9, 0x15, 0x98, 0x77, 0xab, 0x74, 0xd9, 0x6b, 0xa8, 0x4b,
-	0x07, 0x5b, 0x2e, 0x68, 0xfb, 0xab, 0x74, 0x89, 0x8a, 0x0f, 0x9e, 0x81, 0x29, 0x2b, 0x6a, 0x41,
-	0x2b, 0x62, 0x93, 0x89, 0x73, 0xc3, 0xc8, 0x91, 0x0c, 0x25, 0xb9, 0x55, 0x17, 0x67, 0xc8, 0x8b,
-	0x43, 0xe6, 0x78, 0xa4, 0xf6, 0xca, 0xfc, 0x50, 0xcb, 0xe7, 0xf3, 0xa6, 0x9a, 0xf7, 0xfc, 0x77,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x0a, 0x22, 0x77, 0xa1, 0x03, 0x00, 0x00,
+	// 593 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xeb, 0xc4, 0xf9, 0xba, 0x4e, 0xdb, 0xe9, 0xb4, 0xff, 0xfe, 0x43, 0x85, 0x4a, 0x94,
+	0x55, 0xd8, 0xb8, 0x52, 0x90, 0x10, 0x5b, 0x63, 0x8f, 0x8b, 0x8b, 0x3f, 0xa2, 0x1b, 0x07, 0x54,
+	0x36, 0x91, 0x43, 0xa6, 0xa1, 0x90, 0xc6, 0xa6, 0x76, 0x90, 0xf2, 0x52, 0xbc, 0x11, 0x6f, 0xc1,
+	0x92, 0x05, 0x9a, 0x89, 0x07, 0x47, 0xb0, 0xf3, 0xfd, 0x9d, 0xa3, 0x7b, 0x4f, 0x94, 0x33, 0x70,
+	0xf8, 0xc0, 0xf3, 0x3c, 0x59, 0x72, 0x33, 0x7b, 0x4c, 0x8b, 0xf4, 0xe2, 0xc9, 0x32, 0x4d, 0x97,
+	0x2b, 0x7e, 0x25, 0xa7, 0xf9, 0xe6, 0xee, 0x2a, 0x59, 0x6f, 0x4b, 0xe9, 0xd9, 0xdf, 0x52, 0x71,
+	0xff, 0xc0, 0xf3, 0x22, 0x79, 0xc8, 0x76, 0x86, 0xc1, 0x77, 0x1d, 0x5a, 0xc1, 0x6e, 0x1b, 0x7d,
+	0x09, 0x46, 0xb9, 0x38, 0xde, 0x66, 0xbc, 0xa7, 0xf5, 0xb5, 0xe1, 0xd1, 0xe8, 0xcc, 0x2c, 0x65,
+	0x33, 0xa8, 0x34, 0xdc, 0x37, 0x52, 0x13, 0x5a, 0x59, 0xb2, 0x5d, 0xa5, 0xc9, 0xa2, 0x57, 0xeb,
+	0x6b, 0x43, 0x63, 0x74, 0x66, 0xee, 0xce, 0x9a, 0xea, 0xac, 0x69, 0xad, 0xb7, 0xa8, 0x4c, 0xf4,
+	0x29, 0x74, 0x1e, 0xf9, 0xd7, 0x0d, 0xcf, 0x0b, 0x6f, 0xd1, 0xab, 0xf7, 0xb5, 0x61, 0x03, 0x2b,
+	0x40, 0x2f, 0x01, 0xee, 0x73, 0xe4, 0x79, 0x96, 0xae, 0x73, 0xde, 0xd3, 0xfb, 0xda, 0xb0, 0x8d,
+	0x7b, 0x64, 0xf0, 0xab, 0x06, 0xc6, 0x5e, 0x14, 0xda, 0x06, 0x7d, 0xec, 0x85, 0xd7, 0xe4, 0x40,
+	0x7c, 0xd9, 0x6f, 0xac, 0x98, 0x68, 0x14, 0xa0, 0xe9, 0x46, 0xbe, 0x1f, 0xbd, 0x27, 0x35, 0xda,
+	0x85, 0xf6, 0x34, 0x2c, 0xa7, 0x3a, 0xed, 0x40, 0x23, 0x42, 0x87, 0x21, 0xd1, 0x29, 0x81, 0xae,
+	0xfc, 0x9c, 0x21, 0xbb, 0x61, 0x76, 0x4c, 0x1a, 0x15, 0xb1, 0xad, 0xd0, 0x66, 0x3e, 0x69, 0xd2,
+	0x73, 0xa0, 0x25, 0x89, 0x42, 0xd7, 0xc3, 0xc0, 0x8a, 0xbd, 0x28, 0x24, 0x2d, 0xfa, 0x1f, 0x9c,
+	0xec, 0xb8, 0x3b, 0xf5, 0x5d, 0xcf, 0xf7, 0x03, 0x16, 0xc6, 0xa4, 0x4d, 0xcf, 0x80, 0x28, 0x7b,
+	0x30, 0xf6, 0x99, 0x34, 0x77, 0xc4, 0x5a, 0xc7, 0x9b, 0x8c, 0xa7, 0x31, 0x9b, 0x45, 0x63, 0x16,
+	0x12, 0xa0, 0x14, 0x8e, 0x14, 0x99, 0x8e, 0x1d, 0x2b, 0x66, 0xc4, 0xa0, 0x27, 0x70, 0xa8, 0x98,
+	0xed, 0x47, 0x13, 0x46, 0xba, 0xe2, 0x67, 0x20, 0x73, 0xa7, 0xa1, 0x43, 0x0e, 0xe9, 0x31, 0x18,
+	0x91, 0xeb, 0xfa, 0x5e, 0xc8, 0x66, 0x96, 0xfd, 0x96, 0x1c, 0x09, 0xbf, 0x02, 0xc8, 0x7c, 0xeb,
+	0x96, 0x1c, 0x0b, 0x14, 0x44, 0x0e, 0x43, 0x2b, 0x8e, 0x70, 0x66, 0x39, 0x0e, 0x21, 0x22, 0x51,
+	0x85, 0x90, 0x05, 0xd1, 0x3b, 0x46, 0x4e, 0xaa, 0x9c, 0x96, 0x73, 0x33, 0x9d, 0xc4, 0x32, 0x3d,
+	0xa5, 0xff, 0xc3, 0xa9, 0x4a, 0xc0, 0x42, 0x27, 0xc2, 0x09, 0x93, 0xc2, 0x29, 0x05, 0x68, 0x30,
+	0xc4, 0x08, 0xc9, 0xcf, 0xfa, 0x60, 0x01, 0x6d, 0xb6, 0xfe, 0xc6, 0x57, 0x69, 0xc6, 0xe9, 0x00,
+	0x5a, 0x65, 0x0f, 0x64, 0x59, 0x8c, 0x51, 0x5b, 0x95, 0x04, 0x95, 0x40, 0xcf, 0xa1, 0x99, 0x6d,
+	0xe6, 0x5f, 0xf8, 0x56, 0x76, 0xa3, 0x8b, 0xe5, 0x24, 0x4a, 0x90, 0xdf, 0x2f, 0xd7, 0x49, 0xb1,
+	0x79, 0xe4, 0xb2, 0x04, 0x5d, 0xac, 0xc0, 0xe0, 0x87, 0x06, 0xba, 0xfd, 0x29, 0x29, 0x84, 0xad,
+	0xdc, 0xe4, 0x2d, 0xe4, 0x91, 0x0e, 0x56, 0x80, 0xf6, 0xa0, 0x95, 0x6f, 0xe6, 0x9f, 0xf9, 0xc7,
+	0x42, 0x6e, 0xef, 0xa0, 0x1a, 0x85, 0xa2, 0xa2, 0xd5, 0x77, 0x8a, 0x0a, 0xf4, 0x0a, 0x3a, 0x7f,
+	0x1e, 0x81, 0xac, 0x97, 0x31, 0xba, 0xf8, 0xa7, 0xaf, 0xb1, 0x72, 0x60, 0x65, 0xa6, 0x97, 0xa0,
+	0xdf, 0xad, 0x92, 0x65, 0xaf, 0x21, 0x1f, 0x06, 0x98, 0x22, 0xa0, 0xe9, 0xae, 0x92, 0x25, 0x4a,
+	0x3e, 0x78, 0x0e, 0xba, 0x98, 0xa8, 0x01, 0xad, 0x80, 0x4d, 0x26, 0xd6, 0x35, 0x23, 0x07, 0xe2,
+	0x3f, 0x8c, 0x6f, 0x65, 0x41, 0x35, 0x51, 0x50, 0x64, 0x96, 0x43, 0x6a, 0xaf, 0xf5, 0x0f, 0xb5,
+	0x6c, 0x3e, 0x6f, 0xca, 0x7b, 0x2f, 0x7e, 0x0f, 0x00, 0xe3, 0x14, 0x3f, 0xbb, 0xd0, 0x03, 0x00,
+	0x00,
 }
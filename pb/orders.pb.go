@@ -44,6 +44,13 @@ const (
 	// The winning party has accepted the dispute and it is now complete. After the buyer
 	// leaves a review the state should be set to COMPLETE.
 	OrderState_RESOLVED OrderState = 12
+	// The buyer never paid for the order and it expired after the configured age. It is
+	// kept for the order history.
+	OrderState_EXPIRED OrderState = 13
+	// The buyer will pay for the order together with their other orders to the same vendor
+	// in a single batched transaction (buyer side only). If the settlement fails the order
+	// goes back to AWAITING_PAYMENT.
+	OrderState_PENDING_SETTLEMENT OrderState = 14
 )
 
 var OrderState_name = map[int32]string{
@@ -60,6 +67,8 @@ var OrderState_name = map[int32]string{
 	10: "DISPUTED",
 	11: "DECIDED",
 	12: "RESOLVED",
+	13: "EXPIRED",
+	14: "PENDING_SETTLEMENT",
 }
 var OrderState_value = map[string]int32{
 	"PENDING":              0,
@@ -75,6 +84,8 @@ var OrderState_value = map[string]int32{
 	"DISPUTED":             10,
 	"DECIDED":              11,
 	"RESOLVED":             12,
+	"EXPIRED":              13,
+	"PENDING_SETTLEMENT":   14,
 }
 
 func (x OrderState) String() string {
@@ -89,19 +100,20 @@ func init() {
 func init() { proto.RegisterFile("orders.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x44, 0x8f, 0x4d, 0x4e, 0xc3, 0x40,
-	0x0c, 0x46, 0x69, 0x29, 0xfd, 0x71, 0x83, 0xb0, 0xa6, 0x95, 0xe0, 0x0c, 0x2c, 0xd8, 0x70, 0x82,
-	0x61, 0xec, 0x54, 0x16, 0xee, 0x64, 0xd4, 0x64, 0x40, 0x65, 0x83, 0xa8, 0xe8, 0x3a, 0x55, 0xc8,
-	0xa1, 0x39, 0x06, 0x72, 0x10, 0x74, 0xf9, 0xde, 0xb3, 0x17, 0x1f, 0x14, 0x6d, 0xf7, 0x79, 0xec,
-	0xbe, 0x1e, 0x4e, 0x5d, 0xdb, 0xb7, 0xf7, 0xdf, 0x23, 0x80, 0xca, 0x44, 0xdd, 0x7f, 0xf4, 0x47,
-	0xb7, 0x84, 0x59, 0xe2, 0x48, 0x12, 0x37, 0x78, 0xe1, 0xd6, 0x80, 0xfe, 0xd5, 0x4b, 0x23, 0x71,
-	0xf3, 0x9e, 0xfc, 0x7e, 0xcb, 0xb1, 0xc1, 0x91, 0x5b, 0xc1, 0xcd, 0xd9, 0x4a, 0x78, 0xce, 0x09,
-	0xc7, 0xee, 0x0e, 0xd6, 0xff, 0xb2, 0xcc, 0x5a, 0x8a, 0xea, 0x70, 0x7e, 0xe9, 0x6e, 0x61, 0x95,
-	0xfc, 0xae, 0x11, 0xaf, 0xba, 0xff, 0x4b, 0x4c, 0x38, 0x71, 0xd7, 0xb0, 0x38, 0xe3, 0x95, 0x61,
-	0xa8, 0xb6, 0x49, 0xb9, 0x61, 0xc2, 0xa9, 0x2b, 0x60, 0x1e, 0x7c, 0x0c, 0x6c, 0x71, 0x66, 0x44,
-	0x1c, 0x54, 0x22, 0x13, 0xce, 0x8d, 0x76, 0x5c, 0xe6, 0x48, 0x4c, 0xb8, 0x18, 0x9a, 0xd4, 0x29,
-	0xdb, 0x1f, 0xd8, 0x00, 0xe2, 0x20, 0x96, 0x96, 0xbf, 0x87, 0x75, 0xa5, 0x2f, 0x4c, 0x58, 0x3c,
-	0x4d, 0xde, 0xc6, 0xa7, 0xc3, 0x61, 0x3a, 0xec, 0x7e, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x23,
-	0x62, 0x6c, 0x81, 0x07, 0x01, 0x00, 0x00,
+	// 235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xcb, 0x4e, 0xf3, 0x30,
+	0x10, 0x85, 0xff, 0x3f, 0x94, 0x5e, 0xa6, 0x29, 0x8c, 0xdc, 0x0a, 0x78, 0x06, 0x16, 0x6c, 0x78,
+	0x02, 0xe3, 0x99, 0x54, 0x23, 0x5c, 0xc7, 0x4a, 0x1c, 0xa0, 0x6c, 0x2a, 0x2a, 0xba, 0x4e, 0x15,
+	0xf2, 0x1a, 0xbc, 0x33, 0x9a, 0x70, 0xe9, 0xf2, 0x3b, 0xdf, 0xb1, 0xe4, 0x33, 0x90, 0xb7, 0xdd,
+	0xfb, 0xa1, 0xfb, 0xb8, 0x3b, 0x76, 0x6d, 0xdf, 0xde, 0x7e, 0x66, 0x00, 0xa5, 0x06, 0x75, 0xff,
+	0xd6, 0x1f, 0xcc, 0x1c, 0x26, 0x91, 0x03, 0x49, 0x58, 0xe3, 0x3f, 0xb3, 0x02, 0xb4, 0xcf, 0x56,
+	0x92, 0x84, 0xf5, 0x2e, 0xda, 0xed, 0x86, 0x43, 0xc2, 0xff, 0x66, 0x09, 0x97, 0xa7, 0x54, 0xdc,
+	0x63, 0x13, 0x31, 0x33, 0x37, 0xb0, 0xfa, 0x0b, 0x8b, 0xc6, 0x17, 0xe2, 0xfd, 0x50, 0x3f, 0x33,
+	0xd7, 0xb0, 0x8c, 0xb6, 0x4a, 0x62, 0xbd, 0xdf, 0xfe, 0x2a, 0x26, 0x1c, 0x99, 0x05, 0xcc, 0x4e,
+	0x78, 0xae, 0xe8, 0xca, 0x4d, 0xf4, 0x9c, 0x98, 0x70, 0x6c, 0x72, 0x98, 0x3a, 0x1b, 0x1c, 0xab,
+	0x9c, 0x28, 0x11, 0x3b, 0x2f, 0x81, 0x09, 0xa7, 0x4a, 0x15, 0x17, 0x4d, 0x20, 0x26, 0x9c, 0x0d,
+	0x4e, 0xea, 0xd8, 0xe8, 0x3b, 0xd0, 0x01, 0xc4, 0x4e, 0x54, 0xcd, 0xbf, 0x8b, 0x75, 0xe9, 0x9f,
+	0x98, 0x30, 0x57, 0xc5, 0x2f, 0x51, 0x2a, 0x26, 0x5c, 0x98, 0x2b, 0x30, 0x3f, 0x43, 0x77, 0x35,
+	0xa7, 0xe4, 0x79, 0xf8, 0xee, 0xc5, 0xc3, 0xe8, 0x35, 0x3b, 0xee, 0xf7, 0xe3, 0xe1, 0x38, 0xf7,
+	0x5f, 0x03, 0x00, 0xb3, 0xb0, 0x2f, 0x07, 0x2c, 0x01, 0x00, 0x00,
 }
@@ -327,11 +327,12 @@ func (m *Profile_Colors) GetHighlightText() string {
 }
 
 type Profile_Stats struct {
-	FollowerCount  uint32  `protobuf:"varint,1,opt,name=followerCount" json:"followerCount,omitempty"`
-	FollowingCount uint32  `protobuf:"varint,2,opt,name=followingCount" json:"followingCount,omitempty"`
-	ListingCount   uint32  `protobuf:"varint,3,opt,name=listingCount" json:"listingCount,omitempty"`
-	RatingCount    uint32  `protobuf:"varint,4,opt,name=ratingCount" json:"ratingCount,omitempty"`
-	AverageRating  float32 `protobuf:"fixed32,5,opt,name=averageRating" json:"averageRating,omitempty"`
+	FollowerCount       uint32  `protobuf:"varint,1,opt,name=followerCount" json:"followerCount,omitempty"`
+	FollowingCount      uint32  `protobuf:"varint,2,opt,name=followingCount" json:"followingCount,omitempty"`
+	ListingCount        uint32  `protobuf:"varint,3,opt,name=listingCount" json:"listingCount,omitempty"`
+	RatingCount         uint32  `protobuf:"varint,4,opt,name=ratingCount" json:"ratingCount,omitempty"`
+	AverageRating       float32 `protobuf:"fixed32,5,opt,name=averageRating" json:"averageRating,omitempty"`
+	TypicalResponseTime uint32  `protobuf:"varint,6,opt,name=typicalResponseTime" json:"typicalResponseTime,omitempty"`
 }
 
 func (m *Profile_Stats) Reset()                    { *m = Profile_Stats{} }
@@ -374,6 +375,13 @@ func (m *Profile_Stats) GetAverageRating() float32 {
 	return 0
 }
 
+func (m *Profile_Stats) GetTypicalResponseTime() uint32 {
+	if m != nil {
+		return m.TypicalResponseTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Profile)(nil), "Profile")
 	proto.RegisterType((*Profile_Contact)(nil), "Profile.Contact")
@@ -386,48 +394,49 @@ func init() {
 func init() { proto.RegisterFile("profile.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x6e, 0xd4, 0x3a,
-	0x14, 0xc6, 0x35, 0xd3, 0xf9, 0xd3, 0x7a, 0x66, 0xda, 0x5e, 0xeb, 0xaa, 0xb2, 0xa2, 0x2b, 0xdd,
-	0x51, 0x55, 0xc1, 0x88, 0x45, 0x8a, 0x86, 0x3d, 0x12, 0xb4, 0x0b, 0xba, 0x28, 0xaa, 0xd2, 0xb2,
-	0x61, 0xe7, 0x24, 0x9e, 0xc4, 0xc2, 0xb1, 0x23, 0xdb, 0x69, 0x19, 0xf1, 0x08, 0xbc, 0x00, 0xaf,
-	0xc2, 0x92, 0x37, 0x43, 0x3e, 0x76, 0x32, 0x93, 0xc2, 0xce, 0xdf, 0xef, 0x7c, 0xc7, 0x39, 0x76,
-	0x3e, 0xa3, 0x45, 0xad, 0xd5, 0x86, 0x0b, 0x16, 0xd7, 0x5a, 0x59, 0x15, 0xfd, 0x5f, 0x28, 0x55,
-	0x08, 0x76, 0x09, 0x2a, 0x6d, 0x36, 0x97, 0x96, 0x57, 0xcc, 0x58, 0x5a, 0xd5, 0xc1, 0x70, 0x52,
-	0xa9, 0x9c, 0x69, 0x6a, 0x95, 0xf6, 0xe0, 0xfc, 0x27, 0x42, 0xd3, 0x3b, 0xbf, 0x07, 0x3e, 0x43,
-	0x93, 0x9a, 0x31, 0x7d, 0x73, 0x4d, 0x06, 0xcb, 0xc1, 0xea, 0x28, 0x09, 0xca, 0xf1, 0x92, 0xca,
-	0x5c, 0x30, 0x32, 0xf4, 0xdc, 0x2b, 0x8c, 0xd1, 0x48, 0xd2, 0x8a, 0x91, 0x03, 0xa0, 0xb0, 0xc6,
-	0x11, 0x3a, 0x14, 0x2a, 0xa3, 0x96, 0x2b, 0x49, 0x46, 0xc0, 0x3b, 0x8d, 0xff, 0x45, 0x63, 0x9a,
-	0xaa, 0xc6, 0x92, 0x31, 0x14, 0xbc, 0xc0, 0xaf, 0xd0, 0xa9, 0x29, 0x95, 0xb6, 0xd7, 0xcc, 0x64,
-	0x9a, 0xd7, 0xd0, 0x39, 0x01, 0xc3, 0x1f, 0x1c, 0xbe, 0x68, 0x36, 0x4f, 0x64, 0xba, 0x1c, 0xac,
-	0x0e, 0x13, 0x58, 0xbb, 0xe9, 0x1e, 0x99, 0xcc, 0x95, 0x26, 0x87, 0x40, 0x83, 0xc2, 0xff, 0xa1,
-	0xa3, 0xee, 0xb0, 0xe4, 0x08, 0x4a, 0x3b, 0x80, 0x5f, 0xa3, 0x45, 0x27, 0x6e, 0xe4, 0x46, 0x11,
-	0xb4, 0x1c, 0xac, 0x66, 0x6b, 0x14, 0xdf, 0xb6, 0x34, 0xe9, 0x1b, 0xf0, 0x1a, 0xcd, 0x32, 0x25,
-	0x2d, 0xcd, 0x2c, 0xf8, 0x67, 0xe0, 0x3f, 0x8d, 0xc3, 0xe5, 0xc5, 0x57, 0xbe, 0x96, 0xec, 0x9b,
-	0xf0, 0x4b, 0x34, 0xc9, 0x94, 0x50, 0xda, 0x90, 0x39, 0xd8, 0x4f, 0xf6, 0xec, 0x0e, 0x27, 0xa1,
-	0x8c, 0xd7, 0x68, 0x4e, 0x1f, 0xa9, 0xa5, 0xfa, 0x03, 0x35, 0x25, 0x33, 0x64, 0x01, 0xf6, 0xe3,
-	0xce, 0x7e, 0x53, 0xd1, 0x82, 0x25, 0x3d, 0x8f, 0xeb, 0x29, 0x19, 0xcd, 0x59, 0xdb, 0x73, 0xfc,
-	0xf7, 0x9e, 0x7d, 0x0f, 0xbe, 0x40, 0x63, 0x63, 0xa9, 0x35, 0xe4, 0xe4, 0x99, 0xf9, 0xde, 0xd1,
-	0xc4, 0x17, 0xf1, 0x05, 0x5a, 0xa4, 0xdc, 0x66, 0x8a, 0xcb, 0xbb, 0x26, 0xfd, 0xc2, 0xb6, 0xe4,
-	0x14, 0xfe, 0x47, 0x1f, 0xe2, 0xb7, 0x68, 0x2e, 0xa8, 0xb1, 0xb7, 0x2a, 0xe7, 0x1b, 0xce, 0x72,
-	0xf2, 0x0f, 0x6c, 0x19, 0xc5, 0x3e, 0x83, 0x71, 0x9b, 0xc1, 0xf8, 0xa1, 0xcd, 0x60, 0xd2, 0xf3,
-	0x47, 0xdf, 0x07, 0x68, 0x1a, 0x6e, 0x0d, 0x13, 0x34, 0x7d, 0x62, 0xa9, 0xe1, 0x96, 0x85, 0xec,
-	0xb5, 0xd2, 0x85, 0x86, 0x55, 0x94, 0x8b, 0x90, 0x3d, 0x2f, 0xf0, 0x12, 0xcd, 0xea, 0x52, 0x49,
-	0xf6, 0xb1, 0xa9, 0x52, 0xa6, 0x43, 0x02, 0xf7, 0x11, 0x8e, 0xd1, 0xc4, 0xa8, 0x8c, 0x53, 0x41,
-	0x46, 0xcb, 0x83, 0xd5, 0x6c, 0x7d, 0xb6, 0x3b, 0x2a, 0xe0, 0x77, 0x59, 0xa6, 0x1a, 0x69, 0x93,
-	0xe0, 0x8a, 0x3e, 0xa1, 0x45, 0xaf, 0xe0, 0xb2, 0x66, 0xb7, 0x75, 0x3b, 0x0f, 0xac, 0x5d, 0xba,
-	0x1b, 0xc3, 0x34, 0xa4, 0xde, 0xcf, 0xd3, 0x69, 0x37, 0x68, 0xad, 0x95, 0xda, 0x84, 0x61, 0xbc,
-	0x88, 0xbe, 0xa1, 0x31, 0xfc, 0x07, 0xd8, 0x8e, 0xcb, 0x6d, 0xb7, 0x1d, 0x97, 0x5b, 0xd7, 0x62,
-	0x2a, 0x2a, 0xba, 0xb3, 0x81, 0x70, 0x81, 0xae, 0x58, 0xce, 0x9b, 0x2a, 0xec, 0x14, 0x94, 0x73,
-	0x0b, 0xaa, 0x0b, 0x16, 0xde, 0x95, 0x17, 0x6e, 0x24, 0xa5, 0x79, 0xc1, 0x25, 0x15, 0xe1, 0x5d,
-	0x75, 0x3a, 0xfa, 0x31, 0x40, 0x13, 0x1f, 0x34, 0x77, 0xc1, 0xb5, 0xe6, 0x15, 0xd5, 0xed, 0x04,
-	0xad, 0x74, 0xef, 0xc4, 0xb0, 0x4c, 0xc9, 0xdc, 0xd5, 0xfc, 0x20, 0x3b, 0x00, 0x63, 0xb3, 0xaf,
-	0xb6, 0x7d, 0xe3, 0x6e, 0xed, 0x3a, 0x4a, 0x5e, 0x94, 0x82, 0x17, 0xa5, 0x0d, 0xc3, 0xec, 0x80,
-	0x0b, 0x4f, 0x27, 0x1e, 0x5c, 0xab, 0x9f, 0xaa, 0x0f, 0xa3, 0x5f, 0x03, 0x34, 0xbe, 0x6f, 0xc3,
-	0xb6, 0x51, 0x42, 0xa8, 0x27, 0xa6, 0xaf, 0xdc, 0xc5, 0xc3, 0x7c, 0x8b, 0xa4, 0x0f, 0xf1, 0x0b,
-	0x74, 0xec, 0x01, 0x97, 0x85, 0xb7, 0x0d, 0xc1, 0xf6, 0x8c, 0xe2, 0x73, 0x34, 0x17, 0xdc, 0xd8,
-	0xce, 0x75, 0x00, 0xae, 0x1e, 0x73, 0xe1, 0xd1, 0x74, 0x67, 0x19, 0x81, 0x65, 0x1f, 0xb9, 0x99,
-	0xe8, 0x23, 0xd3, 0xee, 0xfd, 0x00, 0x85, 0x33, 0x0c, 0x93, 0x3e, 0x7c, 0x3f, 0xfa, 0x3c, 0xac,
-	0xd3, 0x74, 0x02, 0x41, 0x7f, 0xf3, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x33, 0xea, 0x2f, 0x1b, 0x8b,
-	0x05, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x6e, 0x13, 0x3d,
+	0x14, 0xc5, 0x95, 0x34, 0x7f, 0x5a, 0x27, 0x69, 0xfb, 0xf9, 0x43, 0x95, 0x35, 0x42, 0x22, 0xaa,
+	0x2a, 0x88, 0x58, 0x4c, 0xab, 0xb0, 0x47, 0x82, 0x76, 0x41, 0x17, 0x45, 0xd5, 0xb4, 0x6c, 0xd8,
+	0x79, 0x66, 0x9c, 0x19, 0x0b, 0x8f, 0x3d, 0xb2, 0x9d, 0x96, 0x88, 0x47, 0xe0, 0x05, 0x78, 0x47,
+	0x96, 0xbc, 0x00, 0xf2, 0xb5, 0x67, 0x92, 0x29, 0xdd, 0xf9, 0xfc, 0xee, 0xb9, 0xce, 0xb5, 0xe7,
+	0x38, 0x68, 0x56, 0x6b, 0xb5, 0xe2, 0x82, 0xc5, 0xb5, 0x56, 0x56, 0x45, 0xaf, 0x0a, 0xa5, 0x0a,
+	0xc1, 0xce, 0x41, 0xa5, 0xeb, 0xd5, 0xb9, 0xe5, 0x15, 0x33, 0x96, 0x56, 0x75, 0x30, 0x1c, 0x55,
+	0x2a, 0x67, 0x9a, 0x5a, 0xa5, 0x3d, 0x38, 0xfd, 0x8d, 0xd0, 0xf8, 0xd6, 0xef, 0x81, 0x4f, 0xd0,
+	0xa8, 0x66, 0x4c, 0x5f, 0x5f, 0x91, 0xde, 0xbc, 0xb7, 0x38, 0x48, 0x82, 0x72, 0xbc, 0xa4, 0x32,
+	0x17, 0x8c, 0xf4, 0x3d, 0xf7, 0x0a, 0x63, 0x34, 0x90, 0xb4, 0x62, 0x64, 0x0f, 0x28, 0xac, 0x71,
+	0x84, 0xf6, 0x85, 0xca, 0xa8, 0xe5, 0x4a, 0x92, 0x01, 0xf0, 0x56, 0xe3, 0x17, 0x68, 0x48, 0x53,
+	0xb5, 0xb6, 0x64, 0x08, 0x05, 0x2f, 0xf0, 0x5b, 0x74, 0x6c, 0x4a, 0xa5, 0xed, 0x15, 0x33, 0x99,
+	0xe6, 0x35, 0x74, 0x8e, 0xc0, 0xf0, 0x0f, 0x87, 0x5f, 0x34, 0xab, 0x47, 0x32, 0x9e, 0xf7, 0x16,
+	0xfb, 0x09, 0xac, 0xdd, 0x74, 0x0f, 0x4c, 0xe6, 0x4a, 0x93, 0x7d, 0xa0, 0x41, 0xe1, 0x97, 0xe8,
+	0xa0, 0x3d, 0x2c, 0x39, 0x80, 0xd2, 0x16, 0xe0, 0x0b, 0x34, 0x6b, 0xc5, 0xb5, 0x5c, 0x29, 0x82,
+	0xe6, 0xbd, 0xc5, 0x64, 0x89, 0xe2, 0x9b, 0x86, 0x26, 0x5d, 0x03, 0x5e, 0xa2, 0x49, 0xa6, 0xa4,
+	0xa5, 0x99, 0x05, 0xff, 0x04, 0xfc, 0xc7, 0x71, 0xb8, 0xbc, 0xf8, 0xd2, 0xd7, 0x92, 0x5d, 0x13,
+	0x7e, 0x83, 0x46, 0x99, 0x12, 0x4a, 0x1b, 0x32, 0x05, 0xfb, 0xd1, 0x8e, 0xdd, 0xe1, 0x24, 0x94,
+	0xf1, 0x12, 0x4d, 0xe9, 0x03, 0xb5, 0x54, 0x7f, 0xa2, 0xa6, 0x64, 0x86, 0xcc, 0xc0, 0x7e, 0xd8,
+	0xda, 0xaf, 0x2b, 0x5a, 0xb0, 0xa4, 0xe3, 0x71, 0x3d, 0x25, 0xa3, 0x39, 0x6b, 0x7a, 0x0e, 0x9f,
+	0xef, 0xd9, 0xf5, 0xe0, 0x33, 0x34, 0x34, 0x96, 0x5a, 0x43, 0x8e, 0x9e, 0x98, 0xef, 0x1c, 0x4d,
+	0x7c, 0x11, 0x9f, 0xa1, 0x59, 0xca, 0x6d, 0xa6, 0xb8, 0xbc, 0x5d, 0xa7, 0xdf, 0xd8, 0x86, 0x1c,
+	0xc3, 0xf7, 0xe8, 0x42, 0xfc, 0x1e, 0x4d, 0x05, 0x35, 0xf6, 0x46, 0xe5, 0x7c, 0xc5, 0x59, 0x4e,
+	0xfe, 0x83, 0x2d, 0xa3, 0xd8, 0x67, 0x30, 0x6e, 0x32, 0x18, 0xdf, 0x37, 0x19, 0x4c, 0x3a, 0xfe,
+	0xe8, 0x67, 0x0f, 0x8d, 0xc3, 0xad, 0x61, 0x82, 0xc6, 0x8f, 0x2c, 0x35, 0xdc, 0xb2, 0x90, 0xbd,
+	0x46, 0xba, 0xd0, 0xb0, 0x8a, 0x72, 0x11, 0xb2, 0xe7, 0x05, 0x9e, 0xa3, 0x49, 0x5d, 0x2a, 0xc9,
+	0x3e, 0xaf, 0xab, 0x94, 0xe9, 0x90, 0xc0, 0x5d, 0x84, 0x63, 0x34, 0x32, 0x2a, 0xe3, 0x54, 0x90,
+	0xc1, 0x7c, 0x6f, 0x31, 0x59, 0x9e, 0x6c, 0x8f, 0x0a, 0xf8, 0x43, 0x96, 0xa9, 0xb5, 0xb4, 0x49,
+	0x70, 0x45, 0x5f, 0xd0, 0xac, 0x53, 0x70, 0x59, 0xb3, 0x9b, 0xba, 0x99, 0x07, 0xd6, 0x2e, 0xdd,
+	0x6b, 0xc3, 0x34, 0xa4, 0xde, 0xcf, 0xd3, 0x6a, 0x37, 0x68, 0xad, 0x95, 0x5a, 0x85, 0x61, 0xbc,
+	0x88, 0x7e, 0xa0, 0x21, 0x7c, 0x07, 0xd8, 0x8e, 0xcb, 0x4d, 0xbb, 0x1d, 0x97, 0x1b, 0xd7, 0x62,
+	0x2a, 0x2a, 0xda, 0xb3, 0x81, 0x70, 0x81, 0xae, 0x58, 0xce, 0xd7, 0x55, 0xd8, 0x29, 0x28, 0xe7,
+	0x16, 0x54, 0x17, 0x2c, 0xbc, 0x2b, 0x2f, 0xdc, 0x48, 0x4a, 0xf3, 0x82, 0x4b, 0x2a, 0xc2, 0xbb,
+	0x6a, 0x75, 0xf4, 0xab, 0x87, 0x46, 0x3e, 0x68, 0xee, 0x82, 0x6b, 0xcd, 0x2b, 0xaa, 0x9b, 0x09,
+	0x1a, 0xe9, 0xde, 0x89, 0x61, 0x99, 0x92, 0xb9, 0xab, 0xf9, 0x41, 0xb6, 0x00, 0xc6, 0x66, 0xdf,
+	0x6d, 0xf3, 0xc6, 0xdd, 0xda, 0x75, 0x94, 0xbc, 0x28, 0x05, 0x2f, 0x4a, 0x1b, 0x86, 0xd9, 0x02,
+	0x17, 0x9e, 0x56, 0xdc, 0xbb, 0x56, 0x3f, 0x55, 0x17, 0x46, 0x7f, 0x7a, 0x68, 0x78, 0xd7, 0x84,
+	0x6d, 0xa5, 0x84, 0x50, 0x8f, 0x4c, 0x5f, 0xba, 0x8b, 0x87, 0xf9, 0x66, 0x49, 0x17, 0xe2, 0xd7,
+	0xe8, 0xd0, 0x03, 0x2e, 0x0b, 0x6f, 0xeb, 0x83, 0xed, 0x09, 0xc5, 0xa7, 0x68, 0x2a, 0xb8, 0xb1,
+	0xad, 0x6b, 0x0f, 0x5c, 0x1d, 0xe6, 0xc2, 0xa3, 0xe9, 0xd6, 0x32, 0x00, 0xcb, 0x2e, 0x72, 0x33,
+	0xd1, 0x07, 0xa6, 0xdd, 0xfb, 0x01, 0x0a, 0x67, 0xe8, 0x27, 0x5d, 0x88, 0x2f, 0xd0, 0xff, 0x76,
+	0x53, 0xf3, 0x8c, 0x8a, 0x84, 0x99, 0x5a, 0x49, 0xc3, 0x5c, 0xd4, 0xe1, 0xcf, 0x6b, 0x96, 0x3c,
+	0x57, 0xfa, 0x38, 0xf8, 0xda, 0xaf, 0xd3, 0x74, 0x04, 0x4f, 0xe3, 0xdd, 0xdf, 0x01, 0x00, 0x59,
+	0x82, 0xbb, 0xa2, 0xbd, 0x05, 0x00, 0x00,
 }
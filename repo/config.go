@@ -6,8 +6,18 @@ import (
 	"github.com/ipfs/go-ipfs/repo/config"
 	"io/ioutil"
 	"path"
+	"time"
 )
 
+// Unpaid orders are never expired unless the config sets UnpaidOrderExpiryDays
+const DefaultUnpaidOrderExpiryDays = 0
+
+// Batched orders to a vendor are settled once they add up to this many satoshis...
+const DefaultSettlementThreshold = 1000000
+
+// ...or once the oldest of them has waited this many hours
+const DefaultSettlementMaxHours = 24
+
 var DefaultBootstrapAddresses = []string{
 	"/ip4/107.170.133.32/tcp/4001/ipfs/QmbY4yo9Eifg7DPjL7qK5JvNdiJaRAD7N76gVg4YoQsvgA", // Le Marché Serpette
 	"/ip4/139.59.174.197/tcp/4001/ipfs/QmcCoBtYyduyurcLHRF14QhhA88YojJJpGFuMHoMZuU8sc", // Brixton-Village
@@ -33,6 +43,16 @@ type TorConfig struct {
 	TorControl string
 }
 
+type SettlementConfig struct {
+	Threshold int64
+	MaxHours  int
+}
+
+// The longest a batched order waits before it is settled
+func (c SettlementConfig) MaxAge() time.Duration {
+	return time.Duration(c.MaxHours) * time.Hour
+}
+
 type WalletConfig struct {
 	Type             string
 	Binary           string
@@ -192,6 +212,63 @@ func GetResolverUrl(cfgPath string) (string, error) {
 	return r, nil
 }
 
+func GetEncryptOrderData(cfgPath string) (bool, error) {
+	file, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		return false, err
+	}
+	var cfg interface{}
+	json.Unmarshal(file, &cfg)
+
+	e, ok := cfg.(map[string]interface{})["EncryptOrderData"].(bool)
+	if !ok {
+		return false, nil
+	}
+	return e, nil
+}
+
+// Returns how old an unpaid order must be before it is expired. Zero disables expiry.
+func GetUnpaidOrderExpiry(cfgPath string) (time.Duration, error) {
+	file, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		return 0, err
+	}
+	var cfg interface{}
+	json.Unmarshal(file, &cfg)
+
+	days, ok := cfg.(map[string]interface{})["UnpaidOrderExpiryDays"].(float64)
+	if !ok {
+		days = DefaultUnpaidOrderExpiryDays
+	}
+	if days < 0 {
+		days = 0
+	}
+	return time.Duration(days * float64(time.Hour*24)), nil
+}
+
+// Returns when batched orders are settled. Configs from before batching get the defaults.
+func GetSettlementConfig(cfgPath string) (SettlementConfig, error) {
+	file, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		return SettlementConfig{}, err
+	}
+	var cfg interface{}
+	json.Unmarshal(file, &cfg)
+
+	sc := SettlementConfig{DefaultSettlementThreshold, DefaultSettlementMaxHours}
+	settlement, ok := cfg.(map[string]interface{})["Settlement-config"].(map[string]interface{})
+	if !ok {
+		return sc, nil
+	}
+	if threshold, ok := settlement["Threshold"].(float64); ok && threshold > 0 {
+		sc.Threshold = int64(threshold)
+	}
+	if maxHours, ok := settlement["MaxHours"].(float64); ok && maxHours > 0 {
+		sc.MaxHours = int(maxHours)
+	}
+	return sc, nil
+}
+
 func extendConfigFile(r repo.Repo, key string, value interface{}) error {
 	if err := r.SetConfigKey(key, value); err != nil {
 		return err
@@ -133,6 +133,21 @@ func TestGetResolverUrl(t *testing.T) {
 	}
 }
 
+func TestGetUnpaidOrderExpiry(t *testing.T) {
+	expiry, err := GetUnpaidOrderExpiry(testConfigPath)
+	if err != nil {
+		t.Error("GetUnpaidOrderExpiry threw an unexpected error")
+	}
+	if expiry != 0 {
+		t.Error("Expiry should be off by default, got ", expiry)
+	}
+
+	_, err = GetUnpaidOrderExpiry(nonexistentTestConfigPath)
+	if err == nil {
+		t.Error("GetUnpaidOrderExpiry didn't throw an error")
+	}
+}
+
 func TestExtendConfigFile(t *testing.T) {
 	r, err := fsrepo.Open(testConfigFolder)
 	if err != nil {
@@ -171,3 +186,18 @@ func TestInitConfig(t *testing.T) {
 		t.Error("config.Addresses.Gateway is not set")
 	}
 }
+
+func TestGetSettlementConfig(t *testing.T) {
+	sc, err := GetSettlementConfig(testConfigPath)
+	if err != nil {
+		t.Error("GetSettlementConfig threw an unexpected error")
+	}
+	if sc.Threshold != DefaultSettlementThreshold || sc.MaxHours != DefaultSettlementMaxHours {
+		t.Error("Expected the default settlement config, got ", sc)
+	}
+
+	_, err = GetSettlementConfig(nonexistentTestConfigPath)
+	if err == nil {
+		t.Error("GetSettlementConfig didn't throw an error")
+	}
+}
@@ -0,0 +1,14 @@
+package repo
+
+import (
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// Returns the amount the buyer is currently requested to pay. This is the order's
+// payment amount unless the vendor has since issued a discount.
+func RequestedAmount(contract *pb.RicardianContract) uint64 {
+	if l := len(contract.VendorOrderAdjustments); l > 0 {
+		return contract.VendorOrderAdjustments[l-1].NewAmount
+	}
+	return contract.BuyerOrder.Payment.Amount
+}
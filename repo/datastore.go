@@ -27,6 +27,7 @@ type Datastore interface {
 	Coupons() Coupons
 	TxMetadata() TxMetadata
 	ModeratedStores() ModeratedStores
+	ResponseTimes() ResponseTimes
 	Close()
 }
 
@@ -169,6 +170,9 @@ type Purchases interface {
 	// Return the metadata for all purchases. Also returns the original size of the query.
 	GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]Purchase, int, error)
 
+	// Return the IDs of unfunded purchases awaiting payment which were placed before the given time
+	GetUnpaid(placedBefore time.Time) ([]string, error)
+
 	// Return the number of purchases in the database
 	Count() int
 }
@@ -198,6 +202,9 @@ type Sales interface {
 	// Return the metadata for all sales. Also returns the original size of the query.
 	GetAll(stateFilter []pb.OrderState, searchTerm string, sortByAscending bool, sortByRead bool, limit int, exclude []string) ([]Sale, int, error)
 
+	// Return the IDs of unfunded sales awaiting payment which were placed before the given time
+	GetUnpaid(placedBefore time.Time) ([]string, error)
+
 	// Return the number of sales in the database
 	Count() int
 }
@@ -221,6 +228,9 @@ type Cases interface {
 	// Mark a case as closed in the database
 	MarkAsClosed(caseID string, resolution *pb.DisputeResolution) error
 
+	// Save a dispute resolution without changing the case state
+	UpdateResolution(caseID string, resolution *pb.DisputeResolution) error
+
 	// Delete a case
 	Delete(caseID string) error
 
@@ -295,9 +305,16 @@ type Coupons interface {
 
 type TxMetadata interface {
 
-	// Put metadata for a transaction to the db
+	// Put metadata for a transaction to the db. The label is left as it is, it is set with PutLabel.
 	Put(m Metadata) error
 
+	// Set the user's label for a transaction, replacing any existing label
+	PutLabel(txid, label string) error
+
+	/* Set a generated label for a transaction unless it already has one. This
+	   never overwrites a label set by the user. */
+	PutGeneratedLabel(txid, label string) error
+
 	// Get the metadata given the txid
 	Get(txid string) (Metadata, error)
 
@@ -319,3 +336,15 @@ type ModeratedStores interface {
 	// Delete a moderated store from the database
 	Delete(peerId string) error
 }
+
+type ResponseTimes interface {
+	/* Record how long it took us to respond to an order. Only the first
+	   response to an order is kept. */
+	Put(orderID string, responseTime time.Duration, timestamp time.Time) error
+
+	// Return the most recent response times, newest first
+	GetRecent(limit int) ([]time.Duration, error)
+
+	// Delete the response time for an order
+	Delete(orderID string) error
+}
@@ -11,8 +11,9 @@ import (
 )
 
 type CasesDB struct {
-	db   *sql.DB
-	lock sync.RWMutex
+	db     *sql.DB
+	lock   sync.RWMutex
+	cipher *fieldCipher
 }
 
 func (c *CasesDB) Put(caseID string, state pb.OrderState, buyerOpened bool, claim string) error {
@@ -65,6 +66,12 @@ func (c *CasesDB) UpdateBuyerInfo(caseID string, buyerContract *pb.RicardianCont
 	var buyerOut string
 	var err error
 	if buyerContract != nil {
+		if c.cipher != nil {
+			buyerContract, err = c.cipher.encryptContract(buyerContract)
+			if err != nil {
+				return err
+			}
+		}
 		buyerOut, err = m.MarshalToString(buyerContract)
 		if err != nil {
 			return err
@@ -101,6 +108,12 @@ func (c *CasesDB) UpdateVendorInfo(caseID string, vendorContract *pb.RicardianCo
 	var vendorOut string
 	var err error
 	if vendorContract != nil {
+		if c.cipher != nil {
+			vendorContract, err = c.cipher.encryptContract(vendorContract)
+			if err != nil {
+				return err
+			}
+		}
 		vendorOut, err = m.MarshalToString(vendorContract)
 		if err != nil {
 			return err
@@ -171,6 +184,26 @@ func (c *CasesDB) MarkAsClosed(caseID string, resolution *pb.DisputeResolution)
 	return nil
 }
 
+func (c *CasesDB) UpdateResolution(caseID string, resolution *pb.DisputeResolution) error {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	rOut, err := m.MarshalToString(resolution)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err = c.db.Exec("update cases set disputeResolution=? where caseID=?", rOut, caseID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *CasesDB) Delete(orderID string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -250,7 +283,7 @@ func (c *CasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 					buyerHandle = contract.BuyerOrder.BuyerID.BlockchainID
 				}
 				if contract.BuyerOrder.Payment != nil {
-					total = contract.BuyerOrder.Payment.Amount
+					total = repo.RequestedAmount(contract)
 				}
 			}
 		}
@@ -311,6 +344,9 @@ func (c *CasesDB) GetCaseMetadata(caseID string) (buyerContract, vendorContract
 	} else {
 		brc = nil
 	}
+	if brc != nil && c.cipher != nil {
+		c.cipher.decryptContract(brc)
+	}
 	vrc := new(pb.RicardianContract)
 	if string(vendorCon) != "" {
 		err = jsonpb.UnmarshalString(string(vendorCon), vrc)
@@ -320,6 +356,9 @@ func (c *CasesDB) GetCaseMetadata(caseID string) (buyerContract, vendorContract
 	} else {
 		vrc = nil
 	}
+	if vrc != nil && c.cipher != nil {
+		c.cipher.decryptContract(vrc)
+	}
 
 	read = false
 	if readInt != nil && *readInt == 1 {
@@ -379,6 +418,9 @@ func (c *CasesDB) GetPayoutDetails(caseID string) (buyerContract, vendorContract
 	} else {
 		brc = nil
 	}
+	if brc != nil && c.cipher != nil {
+		c.cipher.decryptContract(brc)
+	}
 	vrc := new(pb.RicardianContract)
 	if string(vendorCon) != "" {
 		err = jsonpb.UnmarshalString(string(vendorCon), vrc)
@@ -388,6 +430,9 @@ func (c *CasesDB) GetPayoutDetails(caseID string) (buyerContract, vendorContract
 	} else {
 		vrc = nil
 	}
+	if vrc != nil && c.cipher != nil {
+		c.cipher.decryptContract(vrc)
+	}
 
 	var buyerOutpointsOut []pb.Outpoint
 	if len(buyerOuts) > 0 {
@@ -417,6 +417,37 @@ func TestMarkAsClosed(t *testing.T) {
 	}
 }
 
+func TestUpdateResolution(t *testing.T) {
+	err := casesdb.Put("caseID", pb.OrderState_DISPUTED, true, "blah")
+	if err != nil {
+		t.Error(err)
+	}
+	err = casesdb.UpdateBuyerInfo("caseID", contract, []string{"someError", "anotherError"}, "addr1", buyerTestOutpoints)
+	if err != nil {
+		t.Error(err)
+	}
+	err = casesdb.UpdateVendorInfo("caseID", contract, []string{"someError", "anotherError"}, "addr2", vendorTestOutpoints)
+	if err != nil {
+		t.Error(err)
+	}
+	d := new(pb.DisputeResolution)
+	d.Resolution = "Proposed by the lead moderator"
+	err = casesdb.UpdateResolution("caseID", d)
+	if err != nil {
+		t.Error(err)
+	}
+	_, _, _, _, state, _, _, _, _, resolution, err := casesdb.GetCaseMetadata("caseID")
+	if err != nil {
+		t.Error(err)
+	}
+	if state != pb.OrderState_DISPUTED {
+		t.Error("Update resolution changed the case state")
+	}
+	if resolution == nil || resolution.Resolution != d.Resolution {
+		t.Error("Failed to save correct dispute resolution")
+	}
+}
+
 func TestCasesDB_GetAll(t *testing.T) {
 	err := casesdb.Put("caseID", 10, true, "blah")
 	if err != nil {
@@ -514,3 +545,56 @@ func TestCasesDB_GetAll(t *testing.T) {
 		t.Error("Returned incorrect number of query cases")
 	}
 }
+
+func TestCasesDB_EncryptedOrderFields(t *testing.T) {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	c, err := newFieldCipher(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encdb := CasesDB{
+		db:     conn,
+		cipher: c,
+	}
+	if err := encdb.Put("caseID", 0, true, "blah"); err != nil {
+		t.Error(err)
+	}
+	if err := encdb.UpdateBuyerInfo("caseID", contract, []string{}, "addr1", buyerTestOutpoints); err != nil {
+		t.Error(err)
+	}
+	if err := encdb.UpdateVendorInfo("caseID", contract, []string{}, "addr2", vendorTestOutpoints); err != nil {
+		t.Error(err)
+	}
+	if contract.BuyerOrder.Shipping.Address != "1234 test ave." {
+		t.Error("UpdateBuyerInfo modified the contract passed in")
+	}
+
+	// Nothing sensitive should be stored in the clear
+	var buyerRaw, vendorRaw []byte
+	err = conn.QueryRow("select buyerContract, vendorContract from cases where caseID=?", "caseID").Scan(&buyerRaw, &vendorRaw)
+	if err != nil {
+		t.Error(err)
+	}
+	for _, raw := range [][]byte{buyerRaw, vendorRaw} {
+		if strings.Contains(string(raw), "1234 test ave.") || strings.Contains(string(raw), "buyer name") {
+			t.Error("Shipping details stored in plaintext")
+		}
+	}
+
+	// Reading decrypts transparently
+	brc, vrc, _, _, _, _, _, _, _, _, err := encdb.GetCaseMetadata("caseID")
+	if err != nil {
+		t.Error(err)
+	}
+	if brc.BuyerOrder.Shipping.Address != "1234 test ave." || vrc.BuyerOrder.Shipping.ShipTo != "buyer name" {
+		t.Error("Failed to decrypt case metadata")
+	}
+	brc, vrc, _, _, _, _, _, err = encdb.GetPayoutDetails("caseID")
+	if err != nil {
+		t.Error(err)
+	}
+	if brc.BuyerOrder.Shipping.Address != "1234 test ave." || vrc.BuyerOrder.Shipping.ShipTo != "buyer name" {
+		t.Error("Failed to decrypt payout details")
+	}
+}
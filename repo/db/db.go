@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"path"
+	"strconv"
 	"sync"
 
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -34,6 +35,7 @@ type SQLiteDatastore struct {
 	coupons         repo.Coupons
 	txMetadata      repo.TxMetadata
 	moderatedStores repo.ModeratedStores
+	responseTimes   repo.ResponseTimes
 	db              *sql.DB
 	lock            sync.RWMutex
 }
@@ -136,6 +138,10 @@ func Create(repoPath, password string, testnet bool) (*SQLiteDatastore, error) {
 			db:   conn,
 			lock: l,
 		},
+		responseTimes: &ResponseTimesDB{
+			db:   conn,
+			lock: l,
+		},
 		db:   conn,
 		lock: l,
 	}
@@ -227,6 +233,28 @@ func (d *SQLiteDatastore) ModeratedStores() repo.ModeratedStores {
 	return d.moderatedStores
 }
 
+func (d *SQLiteDatastore) ResponseTimes() repo.ResponseTimes {
+	return d.responseTimes
+}
+
+// Encrypts the PII fields of orders (shipping address, buyer notes and contact info) in the
+// sales, purchases and cases tables with the given 32 byte key. Fields are decrypted again when
+// an order is read. Orders stored before encryption was enabled are encrypted in place. This
+// must be called before any orders are read or written.
+func (d *SQLiteDatastore) EnableOrderEncryption(key []byte) error {
+	c, err := newFieldCipher(key)
+	if err != nil {
+		return err
+	}
+	if err := c.encryptStoredOrders(d.db); err != nil {
+		return err
+	}
+	d.sales.(*SalesDB).cipher = c
+	d.purchases.(*PurchasesDB).cipher = c
+	d.cases.(*CasesDB).cipher = c
+	return nil
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -270,8 +298,8 @@ func initDatabaseTables(db *sql.DB, password string) error {
 	if password != "" {
 		sqlStmt = "PRAGMA key = '" + password + "';"
 	}
+	sqlStmt += "PRAGMA user_version = " + strconv.Itoa(len(migrations)) + ";"
 	sqlStmt += `
-	PRAGMA user_version = 0;
 	create table config (key text primary key not null, value blob);
 	create table followers (peerID text primary key not null);
 	create table following (peerID text primary key not null);
@@ -281,7 +309,7 @@ func initDatabaseTables(db *sql.DB, password string) error {
 	create table utxos (outpoint text primary key not null, value integer, height integer, scriptPubKey text, watchOnly integer);
 	create table stxos (outpoint text primary key not null, value integer, height integer, scriptPubKey text, watchOnly integer, spendHeight integer, spendTxid text);
 	create table txns (txid text primary key not null, value integer, height integer, timestamp integer, watchOnly integer, tx blob);
-	create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer);
+	create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer, label text);
 	create table inventory (slug text, variantIndex integer, count integer);
 	create index index_inventory on inventory (slug);
 	create table purchases (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, vendorID text, vendorBlockchainID text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob);
@@ -298,6 +326,8 @@ func initDatabaseTables(db *sql.DB, password string) error {
 	create table coupons (slug text, code text, hash text);
 	create index index_coupons on coupons (slug);
 	create table moderatedstores (peerID text primary key not null);
+	create table responsetimes (orderID text primary key not null, responseTime integer, timestamp integer);
+	create index index_responsetimes on responsetimes (timestamp);
 	`
 	_, err := db.Exec(sqlStmt)
 	if err != nil {
@@ -1,8 +1,10 @@
 package db
 
 import (
+	"database/sql"
 	"os"
 	"path"
+	"strings"
 	"testing"
 )
 
@@ -93,3 +95,76 @@ func TestEncryptedDb(t *testing.T) {
 		t.Error("IsEncrypted returned incorrectly")
 	}
 }
+
+func TestEnableOrderEncryption(t *testing.T) {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	d := &SQLiteDatastore{
+		sales:     &SalesDB{db: conn},
+		purchases: &PurchasesDB{db: conn},
+		cases:     &CasesDB{db: conn},
+		db:        conn,
+	}
+
+	// Orders stored before encryption was turned on
+	if err := d.Sales().Put("orderID", *contract, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Purchases().Put("orderID", *contract, 0, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Cases().Put("caseID", 0, true, "blah"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Cases().UpdateBuyerInfo("caseID", contract, []string{}, "addr1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Cases().UpdateVendorInfo("caseID", contract, []string{}, "addr2", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	key := make([]byte, 32)
+	if err := d.EnableOrderEncryption(key); err != nil {
+		t.Fatal(err)
+	}
+	// Running it again must not encrypt twice
+	if err := d.EnableOrderEncryption(key); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, stm := range []string{
+		"select contract || shippingName || shippingAddress from sales",
+		"select contract || shippingName || shippingAddress from purchases",
+		"select buyerContract || vendorContract from cases",
+	} {
+		var raw string
+		if err := conn.QueryRow(stm).Scan(&raw); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(raw, "1234 test ave.") || strings.Contains(raw, "buyer name") {
+			t.Errorf("Stored order left in plaintext: %s", stm)
+		}
+	}
+
+	rc, _, _, _, _, err := d.Sales().GetByOrderId("orderID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc.BuyerOrder.Shipping.Address != "1234 test ave." {
+		t.Error("Failed to decrypt migrated sale")
+	}
+	rc, _, _, _, _, err = d.Purchases().GetByOrderId("orderID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rc.BuyerOrder.Shipping.ShipTo != "buyer name" {
+		t.Error("Failed to decrypt migrated purchase")
+	}
+	brc, vrc, _, _, _, _, _, _, _, _, err := d.Cases().GetCaseMetadata("caseID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if brc.BuyerOrder.Shipping.Address != "1234 test ave." || vrc.BuyerOrder.Shipping.Address != "1234 test ave." {
+		t.Error("Failed to decrypt migrated case")
+	}
+}
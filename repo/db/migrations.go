@@ -0,0 +1,56 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+)
+
+// Schema changes made since the first release, in order. Running migration i moves the
+// database from user_version i to i+1. New databases are created at the latest version by
+// initDatabaseTables, so any schema change made here must be made there too.
+var migrations = []string{
+	// 1: vendor order response times
+	`create table if not exists responsetimes (orderID text primary key not null, responseTime integer, timestamp integer);
+	create index if not exists index_responsetimes on responsetimes (timestamp);`,
+
+	// 2: transaction labels, moving any from the txlabels table of development builds
+	`alter table txmetadata add column label text;
+	create table if not exists txlabels (txid text primary key not null, label text, generated integer);
+	insert or ignore into txmetadata(txid, address, memo, orderID, thumbnail, canBumpFee) select txid, '', '', '', '', 1 from txlabels;
+	update txmetadata set label=(select label from txlabels where txlabels.txid=txmetadata.txid) where txid in (select txid from txlabels);
+	drop table txlabels;`,
+}
+
+// Brings an existing database up to the current schema. It must be called after the
+// database has been decrypted.
+func (d *SQLiteDatastore) Migrate() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return migrate(d.db)
+}
+
+func migrate(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow("PRAGMA user_version;").Scan(&version); err != nil {
+		return err
+	}
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if _, err := tx.Exec("PRAGMA user_version = " + strconv.Itoa(i+1) + ";"); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		log.Infof("Migrated database to version %d", i+1)
+	}
+	return nil
+}
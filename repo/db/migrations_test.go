@@ -0,0 +1,94 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestMigrate(t *testing.T) {
+	// A database created before response times and transaction labels
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_, err := conn.Exec(`PRAGMA user_version = 0;
+	create table config (key text primary key not null, value blob);
+	create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer);
+	insert into txmetadata values('tx1', 'address', 'memo', 'order1', 'thumbnail', 0);`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := migrate(conn); err != nil {
+		t.Fatal(err)
+	}
+	var version int
+	if err := conn.QueryRow("PRAGMA user_version;").Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(migrations) {
+		t.Errorf("Expected version %d, got %d", len(migrations), version)
+	}
+	rt := ResponseTimesDB{db: conn}
+	if err := rt.Put("order1", time.Hour, time.Now()); err != nil {
+		t.Error(err)
+	}
+	md := TxMetadataDB{db: conn}
+	if err := md.PutLabel("tx1", "Rent"); err != nil {
+		t.Error(err)
+	}
+	if m, err := md.Get("tx1"); err != nil || m.Label != "Rent" || m.Memo != "memo" {
+		t.Errorf("Unexpected metadata after migrating %v %v", m, err)
+	}
+
+	// Migrating again is a no-op
+	if err := migrate(conn); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMigrateNewDatabase(t *testing.T) {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	if err := initDatabaseTables(conn, ""); err != nil {
+		t.Fatal(err)
+	}
+	var version int
+	if err := conn.QueryRow("PRAGMA user_version;").Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(migrations) {
+		t.Errorf("New database created at version %d, expected %d", version, len(migrations))
+	}
+	if err := migrate(conn); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMigrateTxLabels(t *testing.T) {
+	// Development builds kept labels in their own table
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_, err := conn.Exec(`PRAGMA user_version = 1;
+	create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer);
+	create table txlabels (txid text primary key not null, label text, generated integer);
+	insert into txmetadata values('tx1', 'address', 'memo', 'order1', 'thumbnail', 0);
+	insert into txlabels values('tx1', 'Rent', 0);
+	insert into txlabels values('tx2', 'Groceries', 0);`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := migrate(conn); err != nil {
+		t.Fatal(err)
+	}
+	md := TxMetadataDB{db: conn}
+	all, err := md.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all["tx1"].Label != "Rent" || all["tx1"].Memo != "memo" || all["tx1"].CanBumpFee {
+		t.Errorf("Label not merged into the existing metadata: %v", all["tx1"])
+	}
+	if all["tx2"].Label != "Groceries" || !all["tx2"].CanBumpFee {
+		t.Errorf("Label without metadata not moved: %v", all["tx2"])
+	}
+	var n int
+	if err := conn.QueryRow("select count(*) from sqlite_master where name='txlabels'").Scan(&n); err != nil || n != 0 {
+		t.Error("txlabels table was not dropped")
+	}
+}
@@ -0,0 +1,248 @@
+package db
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// Prefix marking a field value as encrypted at rest
+const encryptedFieldPrefix = "enc1:"
+
+// fieldCipher encrypts individual order fields which contain PII (shipping address,
+// buyer notes, contact info) before they are written to the sales, purchases and cases tables.
+type fieldCipher struct {
+	aead cipher.AEAD
+}
+
+func newFieldCipher(key []byte) (*fieldCipher, error) {
+	if len(key) != 32 {
+		return nil, errors.New("Order encryption key must be 32 bytes")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &fieldCipher{aead}, nil
+}
+
+func (c *fieldCipher) encrypt(s string) (string, error) {
+	if s == "" {
+		return s, nil
+	}
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	ciphertext := c.aead.Seal(nonce, nonce, []byte(s), nil)
+	return encryptedFieldPrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Values which were not encrypted by us are returned unchanged
+func (c *fieldCipher) decrypt(s string) string {
+	if !strings.HasPrefix(s, encryptedFieldPrefix) {
+		return s
+	}
+	b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, encryptedFieldPrefix))
+	if err != nil || len(b) < c.aead.NonceSize() {
+		return s
+	}
+	plaintext, err := c.aead.Open(nil, b[:c.aead.NonceSize()], b[c.aead.NonceSize():], nil)
+	if err != nil {
+		log.Warning("Failed to decrypt order field")
+		return s
+	}
+	return string(plaintext)
+}
+
+// Returns the sensitive fields of the buyer's order
+func sensitiveOrderFields(order *pb.Order) []*string {
+	var fields []*string
+	if order == nil {
+		return fields
+	}
+	fields = append(fields, &order.AlternateContactInfo)
+	if order.Shipping != nil {
+		fields = append(fields,
+			&order.Shipping.ShipTo,
+			&order.Shipping.Address,
+			&order.Shipping.City,
+			&order.Shipping.State,
+			&order.Shipping.PostalCode,
+			&order.Shipping.AddressNotes,
+		)
+	}
+	for _, item := range order.Items {
+		fields = append(fields, &item.Memo)
+	}
+	return fields
+}
+
+// Returns a copy of the contract with the sensitive order fields encrypted. The
+// contract passed in is not modified.
+func (c *fieldCipher) encryptContract(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	rc := proto.Clone(contract).(*pb.RicardianContract)
+	for _, f := range sensitiveOrderFields(rc.BuyerOrder) {
+		enc, err := c.encrypt(*f)
+		if err != nil {
+			return nil, err
+		}
+		*f = enc
+	}
+	return rc, nil
+}
+
+// Decrypts the sensitive order fields in place
+func (c *fieldCipher) decryptContract(contract *pb.RicardianContract) {
+	for _, f := range sensitiveOrderFields(contract.BuyerOrder) {
+		*f = c.decrypt(*f)
+	}
+}
+
+// Returns true if any sensitive order field holds a value which isn't encrypted
+func hasPlaintextFields(contract *pb.RicardianContract) bool {
+	for _, f := range sensitiveOrderFields(contract.BuyerOrder) {
+		if *f != "" && !strings.HasPrefix(*f, encryptedFieldPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Re-encrypts a stored contract if it still holds plaintext fields. The returned
+// contract is nil if the stored one needs no changes.
+func (c *fieldCipher) migrateContract(stored []byte) (*pb.RicardianContract, string, error) {
+	if len(stored) == 0 {
+		return nil, "", nil
+	}
+	rc := new(pb.RicardianContract)
+	if err := jsonpb.UnmarshalString(string(stored), rc); err != nil {
+		return nil, "", err
+	}
+	if !hasPlaintextFields(rc) {
+		return nil, "", nil
+	}
+	c.decryptContract(rc)
+	encrypted, err := c.encryptContract(rc)
+	if err != nil {
+		return nil, "", err
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(encrypted)
+	if err != nil {
+		return nil, "", err
+	}
+	return encrypted, out, nil
+}
+
+// Encrypts the orders and cases which were written before order encryption was enabled.
+// Rows which are already encrypted are left as they are, so this is safe to run on every start.
+func (c *fieldCipher) encryptStoredOrders(db *sql.DB) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, table := range []string{"sales", "purchases"} {
+		if err := c.encryptStoredTable(tx, table); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := c.encryptStoredCases(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *fieldCipher) encryptStoredTable(tx *sql.Tx, table string) error {
+	stored := make(map[string][]byte)
+	rows, err := tx.Query("select orderID, contract from " + table)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var orderID string
+		var contract []byte
+		if err := rows.Scan(&orderID, &contract); err != nil {
+			rows.Close()
+			return err
+		}
+		stored[orderID] = contract
+	}
+	rows.Close()
+
+	for orderID, contract := range stored {
+		rc, out, err := c.migrateContract(contract)
+		if err != nil {
+			return err
+		}
+		if rc == nil {
+			continue
+		}
+		var shippingName, shippingAddress string
+		if rc.BuyerOrder != nil && rc.BuyerOrder.Shipping != nil {
+			shippingName = rc.BuyerOrder.Shipping.ShipTo
+			shippingAddress = rc.BuyerOrder.Shipping.Address
+		}
+		_, err = tx.Exec("update "+table+" set contract=?, shippingName=?, shippingAddress=? where orderID=?", out, shippingName, shippingAddress, orderID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *fieldCipher) encryptStoredCases(tx *sql.Tx) error {
+	type caseContracts struct {
+		buyer, vendor []byte
+	}
+	stored := make(map[string]caseContracts)
+	rows, err := tx.Query("select caseID, buyerContract, vendorContract from cases")
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var caseID string
+		var cc caseContracts
+		if err := rows.Scan(&caseID, &cc.buyer, &cc.vendor); err != nil {
+			rows.Close()
+			return err
+		}
+		stored[caseID] = cc
+	}
+	rows.Close()
+
+	for caseID, cc := range stored {
+		for col, contract := range map[string][]byte{"buyerContract": cc.buyer, "vendorContract": cc.vendor} {
+			rc, out, err := c.migrateContract(contract)
+			if err != nil {
+				return err
+			}
+			if rc == nil {
+				continue
+			}
+			if _, err := tx.Exec("update cases set "+col+"=? where caseID=?", out, caseID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -13,8 +13,9 @@ import (
 )
 
 type PurchasesDB struct {
-	db   *sql.DB
-	lock sync.RWMutex
+	db     *sql.DB
+	lock   sync.RWMutex
+	cipher *fieldCipher
 }
 
 func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool) error {
@@ -31,6 +32,13 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 		Indent:       "    ",
 		OrigName:     false,
 	}
+	if p.cipher != nil {
+		encrypted, err := p.cipher.encryptContract(&contract)
+		if err != nil {
+			return err
+		}
+		contract = *encrypted
+	}
 	out, err := m.MarshalToString(&contract)
 
 	tx, err := p.db.Begin()
@@ -62,7 +70,7 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 		int(state),
 		readInt,
 		int(contract.BuyerOrder.Timestamp.Seconds),
-		int(contract.BuyerOrder.Payment.Amount),
+		int(repo.RequestedAmount(&contract)),
 		contract.VendorListings[0].Item.Images[0].Tiny,
 		contract.VendorListings[0].VendorID.PeerID,
 		blockchainID,
@@ -137,7 +145,7 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorBlockchainID", "shippingName", "shippingAddress", "state", "read"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
-		searchColumns:   []string{"orderID", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorBlockchainID", "shippingName", "shippingAddress", "paymentAddr"},
+		searchColumns:   p.searchColumns(),
 		sortByAscending: sortByAscending,
 		sortByRead:      sortByRead,
 		id:              "orderID",
@@ -167,6 +175,11 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 		if err := jsonpb.UnmarshalString(string(contract), rc); err != nil {
 			return ret, 0, err
 		}
+		if p.cipher != nil {
+			p.cipher.decryptContract(rc)
+			shippingName = p.cipher.decrypt(shippingName)
+			shippingAddr = p.cipher.decrypt(shippingAddr)
+		}
 		var slug string
 		var moderated bool
 		if len(rc.VendorListings) > 0 {
@@ -205,6 +218,25 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 	return ret, count, nil
 }
 
+func (p *PurchasesDB) GetUnpaid(placedBefore time.Time) ([]string, error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	rows, err := p.db.Query("select orderID from purchases where state=? and (funded is null or funded=0) and timestamp<?", int(pb.OrderState_AWAITING_PAYMENT), int(placedBefore.Unix()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []string
+	for rows.Next() {
+		var orderID string
+		if err := rows.Scan(&orderID); err != nil {
+			return nil, err
+		}
+		ret = append(ret, orderID)
+	}
+	return ret, nil
+}
+
 func (p *PurchasesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract, pb.OrderState, bool, []*spvwallet.TransactionRecord, error) {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -223,6 +255,9 @@ func (p *PurchasesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContra
 	if err != nil {
 		return nil, pb.OrderState(0), false, nil, err
 	}
+	if p.cipher != nil {
+		p.cipher.decryptContract(rc)
+	}
 	funded := false
 	if fundedInt != nil && *fundedInt == 1 {
 		funded = true
@@ -251,6 +286,9 @@ func (p *PurchasesDB) GetByOrderId(orderId string) (*pb.RicardianContract, pb.Or
 	if err != nil {
 		return nil, pb.OrderState(0), false, nil, false, err
 	}
+	if p.cipher != nil {
+		p.cipher.decryptContract(rc)
+	}
 	funded := false
 	if fundedInt != nil && *fundedInt == 1 {
 		funded = true
@@ -264,6 +302,15 @@ func (p *PurchasesDB) GetByOrderId(orderId string) (*pb.RicardianContract, pb.Or
 	return rc, pb.OrderState(stateInt), funded, records, read, nil
 }
 
+// When order encryption is enabled the shipping columns only hold ciphertext, so they are
+// left out of the search.
+func (p *PurchasesDB) searchColumns() []string {
+	if p.cipher != nil {
+		return []string{"orderID", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorBlockchainID", "paymentAddr"}
+	}
+	return []string{"orderID", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorBlockchainID", "shippingName", "shippingAddress", "paymentAddr"}
+}
+
 func (p *PurchasesDB) Count() int {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -10,7 +10,9 @@ import (
 	"github.com/OpenBazaar/spvwallet"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 var purdb PurchasesDB
@@ -394,3 +396,31 @@ func TestPurchasesDB_GetAll(t *testing.T) {
 		t.Error("Returned incorrect number of query purchases")
 	}
 }
+
+func TestPurchasesDB_GetUnpaid(t *testing.T) {
+	old, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour * 24 * 40))
+	recent, _ := ptypes.TimestampProto(time.Now())
+	put := func(orderID string, ts *timestamp.Timestamp, state pb.OrderState) {
+		c := proto.Clone(contract).(*pb.RicardianContract)
+		c.BuyerOrder.Timestamp = ts
+		if err := purdb.Put(orderID, *c, state, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("unpaidOld", old, pb.OrderState_AWAITING_PAYMENT)
+	put("unpaidRecent", recent, pb.OrderState_AWAITING_PAYMENT)
+	put("fundedOld", old, pb.OrderState_AWAITING_PAYMENT)
+	put("settlingOld", old, pb.OrderState_PENDING_SETTLEMENT)
+	purdb.UpdateFunding("fundedOld", true, nil)
+
+	unpaid, err := purdb.GetUnpaid(time.Now().Add(-time.Hour * 24 * 30))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(unpaid) != 1 || unpaid[0] != "unpaidOld" {
+		t.Errorf("Expected only unpaidOld, got %v", unpaid)
+	}
+	for _, id := range []string{"unpaidOld", "unpaidRecent", "fundedOld", "settlingOld"} {
+		purdb.Delete(id)
+	}
+}
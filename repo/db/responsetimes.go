@@ -0,0 +1,59 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type ResponseTimesDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (r *ResponseTimesDB) Put(orderID string, responseTime time.Duration, timestamp time.Time) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	tx, _ := r.db.Begin()
+	stmt, _ := tx.Prepare("insert or ignore into responsetimes(orderID, responseTime, timestamp) values(?,?,?)")
+
+	defer stmt.Close()
+	_, err := stmt.Exec(orderID, int64(responseTime/time.Second), int(timestamp.Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (r *ResponseTimesDB) GetRecent(limit int) ([]time.Duration, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	stm := "select responseTime from responsetimes order by timestamp desc limit " + strconv.Itoa(limit)
+	rows, err := r.db.Query(stm)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []time.Duration
+	for rows.Next() {
+		var seconds int64
+		if err := rows.Scan(&seconds); err != nil {
+			return nil, err
+		}
+		ret = append(ret, time.Duration(seconds)*time.Second)
+	}
+	return ret, nil
+}
+
+func (r *ResponseTimesDB) Delete(orderID string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.db.Exec("delete from responsetimes where orderID=?", orderID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
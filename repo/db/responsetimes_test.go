@@ -0,0 +1,83 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+var respDB ResponseTimesDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	respDB = ResponseTimesDB{
+		db: conn,
+	}
+}
+
+func TestResponseTimesDB_Put(t *testing.T) {
+	err := respDB.Put("order1", time.Hour, time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := respDB.db.Prepare("select responseTime from responsetimes where orderID=?")
+	defer stmt.Close()
+	var seconds int
+	err = stmt.QueryRow("order1").Scan(&seconds)
+	if err != nil {
+		t.Error(err)
+	}
+	if seconds != 3600 {
+		t.Errorf("Expected 3600 seconds, got %d", seconds)
+	}
+
+	// Only the first response is kept
+	err = respDB.Put("order1", time.Minute, time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+	err = stmt.QueryRow("order1").Scan(&seconds)
+	if err != nil {
+		t.Error(err)
+	}
+	if seconds != 3600 {
+		t.Error("Second put overwrote the first response time")
+	}
+	respDB.Delete("order1")
+}
+
+func TestResponseTimesDB_GetRecent(t *testing.T) {
+	now := time.Now()
+	respDB.Put("a", time.Minute, now.Add(-time.Hour*2))
+	respDB.Put("b", time.Minute*2, now.Add(-time.Hour))
+	respDB.Put("c", time.Minute*3, now)
+	times, err := respDB.GetRecent(2)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(times) != 2 {
+		t.Fatalf("Expected 2 response times, got %d", len(times))
+	}
+	if times[0] != time.Minute*3 || times[1] != time.Minute*2 {
+		t.Error("Returned incorrect response times")
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		respDB.Delete(id)
+	}
+}
+
+func TestResponseTimesDB_Delete(t *testing.T) {
+	respDB.Put("order2", time.Minute, time.Now())
+	err := respDB.Delete("order2")
+	if err != nil {
+		t.Error(err)
+	}
+	times, err := respDB.GetRecent(10)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(times) != 0 {
+		t.Error("Failed to delete response time")
+	}
+}
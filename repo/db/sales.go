@@ -13,8 +13,9 @@ import (
 )
 
 type SalesDB struct {
-	db   *sql.DB
-	lock sync.RWMutex
+	db     *sql.DB
+	lock   sync.RWMutex
+	cipher *fieldCipher
 }
 
 func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool) error {
@@ -31,6 +32,13 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 		Indent:       "    ",
 		OrigName:     false,
 	}
+	if s.cipher != nil {
+		encrypted, err := s.cipher.encryptContract(&contract)
+		if err != nil {
+			return err
+		}
+		contract = *encrypted
+	}
 	out, err := m.MarshalToString(&contract)
 
 	tx, err := s.db.Begin()
@@ -63,7 +71,7 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 		int(state),
 		readInt,
 		int(contract.BuyerOrder.Timestamp.Seconds),
-		int(contract.BuyerOrder.Payment.Amount),
+		int(repo.RequestedAmount(&contract)),
 		contract.VendorListings[0].Item.Images[0].Tiny,
 		contract.BuyerOrder.BuyerID.PeerID,
 		blockchainID,
@@ -137,7 +145,7 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerBlockchainID", "shippingName", "shippingAddress", "state", "read"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
-		searchColumns:   []string{"orderID", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerBlockchainID", "shippingName", "shippingAddress", "paymentAddr"},
+		searchColumns:   s.searchColumns(),
 		sortByAscending: sortByAscending,
 		sortByRead:      sortByRead,
 		id:              "orderID",
@@ -167,6 +175,11 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 		if err := jsonpb.UnmarshalString(string(contract), rc); err != nil {
 			return ret, 0, err
 		}
+		if s.cipher != nil {
+			s.cipher.decryptContract(rc)
+			shippingName = s.cipher.decrypt(shippingName)
+			shippingAddr = s.cipher.decrypt(shippingAddr)
+		}
 		var slug string
 		if len(rc.VendorListings) > 0 {
 			slug = rc.VendorListings[0].Slug
@@ -206,6 +219,25 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 	return ret, count, nil
 }
 
+func (s *SalesDB) GetUnpaid(placedBefore time.Time) ([]string, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	rows, err := s.db.Query("select orderID from sales where state=? and (funded is null or funded=0) and timestamp<?", int(pb.OrderState_AWAITING_PAYMENT), int(placedBefore.Unix()))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []string
+	for rows.Next() {
+		var orderID string
+		if err := rows.Scan(&orderID); err != nil {
+			return nil, err
+		}
+		ret = append(ret, orderID)
+	}
+	return ret, nil
+}
+
 func (s *SalesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract, pb.OrderState, bool, []*spvwallet.TransactionRecord, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -224,6 +256,9 @@ func (s *SalesDB) GetByPaymentAddress(addr btc.Address) (*pb.RicardianContract,
 	if err != nil {
 		return nil, pb.OrderState(0), false, nil, err
 	}
+	if s.cipher != nil {
+		s.cipher.decryptContract(rc)
+	}
 	funded := false
 	if fundedInt != nil && *fundedInt == 1 {
 		funded = true
@@ -252,6 +287,9 @@ func (s *SalesDB) GetByOrderId(orderId string) (*pb.RicardianContract, pb.OrderS
 	if err != nil {
 		return nil, pb.OrderState(0), false, nil, false, err
 	}
+	if s.cipher != nil {
+		s.cipher.decryptContract(rc)
+	}
 	funded := false
 	if fundedInt != nil && *fundedInt == 1 {
 		funded = true
@@ -265,6 +303,15 @@ func (s *SalesDB) GetByOrderId(orderId string) (*pb.RicardianContract, pb.OrderS
 	return rc, pb.OrderState(stateInt), funded, records, read, nil
 }
 
+// When order encryption is enabled the shipping columns only hold ciphertext, so they are
+// left out of the search.
+func (s *SalesDB) searchColumns() []string {
+	if s.cipher != nil {
+		return []string{"orderID", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerBlockchainID", "paymentAddr"}
+	}
+	return []string{"orderID", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerBlockchainID", "shippingName", "shippingAddress", "paymentAddr"}
+}
+
 func (s *SalesDB) Count() int {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
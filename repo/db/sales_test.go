@@ -10,7 +10,9 @@ import (
 	"github.com/OpenBazaar/spvwallet"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 var saldb SalesDB
@@ -391,3 +393,90 @@ func TestSalesDB_GetAll(t *testing.T) {
 		t.Error("Returned incorrect number of query sales")
 	}
 }
+
+func TestSalesDB_EncryptedOrderFields(t *testing.T) {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	c, err := newFieldCipher(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encdb := SalesDB{
+		db:     conn,
+		cipher: c,
+	}
+	err = encdb.Put("orderID", *contract, 0, false)
+	if err != nil {
+		t.Error(err)
+	}
+	if contract.BuyerOrder.Shipping.Address != "1234 test ave." {
+		t.Error("Put modified the contract passed in")
+	}
+
+	// Nothing sensitive should be stored in the clear
+	var raw []byte
+	var shippingName, shippingAddress string
+	err = conn.QueryRow("select contract, shippingName, shippingAddress from sales where orderID=?", "orderID").Scan(&raw, &shippingName, &shippingAddress)
+	if err != nil {
+		t.Error(err)
+	}
+	if strings.Contains(string(raw), "1234 test ave.") || strings.Contains(string(raw), "buyer name") {
+		t.Error("Shipping details stored in plaintext")
+	}
+	if shippingName == "buyer name" || shippingAddress == "1234 test ave." {
+		t.Error("Shipping columns stored in plaintext")
+	}
+
+	// Reading decrypts transparently
+	rc, _, _, _, _, err := encdb.GetByOrderId("orderID")
+	if err != nil {
+		t.Error(err)
+	}
+	if rc.BuyerOrder.Shipping.Address != "1234 test ave." || rc.BuyerOrder.Shipping.ShipTo != "buyer name" {
+		t.Error("Failed to decrypt shipping details")
+	}
+	sales, _, err := encdb.GetAll(nil, "", false, false, -1, []string{})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(sales) != 1 || sales[0].ShippingName != "buyer name" || sales[0].ShippingAddress != "1234 test ave." {
+		t.Error("Failed to decrypt shipping columns")
+	}
+
+	// Searching on an encrypted column finds nothing
+	sales, _, err = encdb.GetAll(nil, "1234 test", false, false, -1, []string{})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(sales) != 0 {
+		t.Error("Search should not match encrypted columns")
+	}
+}
+
+func TestSalesDB_GetUnpaid(t *testing.T) {
+	old, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour * 24 * 40))
+	recent, _ := ptypes.TimestampProto(time.Now())
+	put := func(orderID string, ts *timestamp.Timestamp, state pb.OrderState) {
+		c := proto.Clone(contract).(*pb.RicardianContract)
+		c.BuyerOrder.Timestamp = ts
+		if err := saldb.Put(orderID, *c, state, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("unpaidOld", old, pb.OrderState_AWAITING_PAYMENT)
+	put("unpaidRecent", recent, pb.OrderState_AWAITING_PAYMENT)
+	put("fundedOld", old, pb.OrderState_AWAITING_PAYMENT)
+	put("pendingOld", old, pb.OrderState_PENDING)
+	saldb.UpdateFunding("fundedOld", true, nil)
+
+	unpaid, err := saldb.GetUnpaid(time.Now().Add(-time.Hour * 24 * 30))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(unpaid) != 1 || unpaid[0] != "unpaidOld" {
+		t.Errorf("Expected only unpaidOld, got %v", unpaid)
+	}
+	for _, id := range []string{"unpaidOld", "unpaidRecent", "fundedOld", "pendingOld"} {
+		saldb.Delete(id)
+	}
+}
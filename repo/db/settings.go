@@ -104,6 +104,9 @@ func (s *SettingsDB) Update(settings repo.SettingsData) error {
 	if settings.MisPaymentBuffer == nil {
 		settings.MisPaymentBuffer = current.MisPaymentBuffer
 	}
+	if settings.RotatePayoutAddrs == nil {
+		settings.RotatePayoutAddrs = current.RotatePayoutAddrs
+	}
 	if settings.SMTPSettings == nil {
 		settings.SMTPSettings = current.SMTPSettings
 	}
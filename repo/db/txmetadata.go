@@ -15,7 +15,7 @@ func (t *TxMetadataDB) Put(m repo.Metadata) error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	tx, _ := t.db.Begin()
-	stmt, err := tx.Prepare("insert or replace into txmetadata(txid, address, memo, orderID, thumbnail, canBumpFee) values(?,?,?,?,?,?)")
+	stmt, err := tx.Prepare("insert or replace into txmetadata(txid, address, memo, orderID, thumbnail, canBumpFee, label) values(?,?,?,?,?,?,(select label from txmetadata where txid=?))")
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -25,7 +25,7 @@ func (t *TxMetadataDB) Put(m repo.Metadata) error {
 	if m.CanBumpFee {
 		bumpable = 1
 	}
-	_, err = stmt.Exec(m.Txid, m.Address, m.Memo, m.OrderId, m.Thumbnail, bumpable)
+	_, err = stmt.Exec(m.Txid, m.Address, m.Memo, m.OrderId, m.Thumbnail, bumpable, m.Txid)
 	if err != nil {
 		tx.Rollback()
 		return err
@@ -34,15 +34,45 @@ func (t *TxMetadataDB) Put(m repo.Metadata) error {
 	return nil
 }
 
+func (t *TxMetadataDB) PutLabel(txid, label string) error {
+	return t.putLabel("update txmetadata set label=? where txid=?", txid, label)
+}
+
+func (t *TxMetadataDB) PutGeneratedLabel(txid, label string) error {
+	return t.putLabel("update txmetadata set label=? where txid=? and (label is null or label='')", txid, label)
+}
+
+// Transactions without metadata get an empty entry to hold the label. Those can have their fee
+// bumped, as transactions without metadata can.
+func (t *TxMetadataDB) putLabel(update, txid, label string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("insert or ignore into txmetadata(txid, address, memo, orderID, thumbnail, canBumpFee) values(?,'','','','',1)", txid)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	_, err = tx.Exec(update, label, txid)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
 func (t *TxMetadataDB) Get(txid string) (repo.Metadata, error) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	var m repo.Metadata
-	stmt, err := t.db.Prepare("select txid, address, memo, orderID, thumbnail, canBumpFee from txmetadata where txid=?")
+	stmt, err := t.db.Prepare("select txid, address, memo, orderID, thumbnail, canBumpFee, coalesce(label, '') from txmetadata where txid=?")
 	defer stmt.Close()
-	var id, address, memo, orderId, thumbnail string
+	var id, address, memo, orderId, thumbnail, label string
 	var canBumpFee int
-	err = stmt.QueryRow(txid).Scan(&id, &address, &memo, &orderId, &thumbnail, &canBumpFee)
+	err = stmt.QueryRow(txid).Scan(&id, &address, &memo, &orderId, &thumbnail, &canBumpFee, &label)
 	if err != nil {
 		return m, err
 	}
@@ -50,7 +80,7 @@ func (t *TxMetadataDB) Get(txid string) (repo.Metadata, error) {
 	if canBumpFee > 0 {
 		bumpable = true
 	}
-	m = repo.Metadata{id, address, memo, orderId, thumbnail, bumpable}
+	m = repo.Metadata{id, address, memo, orderId, thumbnail, bumpable, label}
 	return m, nil
 }
 
@@ -58,16 +88,16 @@ func (t *TxMetadataDB) GetAll() (map[string]repo.Metadata, error) {
 	t.lock.RLock()
 	defer t.lock.RUnlock()
 	ret := make(map[string]repo.Metadata)
-	stm := "select txid, address, memo, orderID, thumbnail, canBumpFee from txmetadata"
+	stm := "select txid, address, memo, orderID, thumbnail, canBumpFee, coalesce(label, '') from txmetadata"
 	rows, err := t.db.Query(stm)
 	if err != nil {
 		return ret, err
 	}
 	defer rows.Close()
 	for rows.Next() {
-		var txid, address, memo, orderId, thumbnail string
+		var txid, address, memo, orderId, thumbnail, label string
 		var canBumpFee int
-		if err := rows.Scan(&txid, &address, &memo, &orderId, &thumbnail, &canBumpFee); err != nil {
+		if err := rows.Scan(&txid, &address, &memo, &orderId, &thumbnail, &canBumpFee, &label); err != nil {
 			return ret, err
 		}
 		bumpable := false
@@ -81,6 +111,7 @@ func (t *TxMetadataDB) GetAll() (map[string]repo.Metadata, error) {
 			OrderId:    orderId,
 			Thumbnail:  thumbnail,
 			CanBumpFee: bumpable,
+			Label:      label,
 		}
 		ret[txid] = m
 	}
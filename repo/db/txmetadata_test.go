@@ -15,7 +15,7 @@ func init() {
 	metDB = TxMetadataDB{
 		db: conn,
 	}
-	m = repo.Metadata{"16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f", "1Xtkf3Rdq6eix4tFXpEuHdXfubt3Mt452", "Some memo", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "QmZY1kx6VrNjgDB4SJDByxvSVuiBfsisRLdUMJRDppTTsS", false}
+	m = repo.Metadata{"16e4a210d8c798f7d7a32584038c1f55074377bdd19f4caa24edb657fff9538f", "1Xtkf3Rdq6eix4tFXpEuHdXfubt3Mt452", "Some memo", "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "QmZY1kx6VrNjgDB4SJDByxvSVuiBfsisRLdUMJRDppTTsS", false, ""}
 }
 
 func TestTxMetadataDB_Put(t *testing.T) {
@@ -134,3 +134,82 @@ func TestTxMetadataDB_Delete(t *testing.T) {
 		t.Error("TxMetadataDB failed to delete row")
 	}
 }
+
+func TestTxMetadataDB_PutLabel(t *testing.T) {
+	err := metDB.Put(m)
+	if err != nil {
+		t.Error(err)
+	}
+	err = metDB.PutLabel(m.Txid, "Rent")
+	if err != nil {
+		t.Error(err)
+	}
+	// Updating the metadata keeps the label
+	err = metDB.Put(m)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := metDB.Get(m.Txid)
+	if err != nil {
+		t.Error(err)
+	}
+	if ret.Label != "Rent" || ret.Memo != m.Memo {
+		t.Errorf("Expected label Rent with the memo kept, got %v", ret)
+	}
+
+	// Transactions without metadata can be labelled
+	err = metDB.PutLabel("tx2", "Groceries")
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err = metDB.Get("tx2")
+	if err != nil {
+		t.Error(err)
+	}
+	if ret.Label != "Groceries" || !ret.CanBumpFee {
+		t.Errorf("Unexpected metadata for a labelled transaction %v", ret)
+	}
+
+	// An empty label removes it
+	metDB.PutLabel("tx2", "")
+	ret, _ = metDB.Get("tx2")
+	if ret.Label != "" {
+		t.Error("Label was not removed")
+	}
+	metDB.Delete(m.Txid)
+	metDB.Delete("tx2")
+}
+
+func TestTxMetadataDB_PutGeneratedLabel(t *testing.T) {
+	metDB.PutLabel("tx3", "Mine")
+	err := metDB.PutGeneratedLabel("tx3", "Payment for order abc")
+	if err != nil {
+		t.Error(err)
+	}
+	ret, _ := metDB.Get("tx3")
+	if ret.Label != "Mine" {
+		t.Error("Generated label overwrote the user's label")
+	}
+
+	err = metDB.Put(m)
+	if err != nil {
+		t.Error(err)
+	}
+	err = metDB.PutGeneratedLabel(m.Txid, "Payment for order abc")
+	if err != nil {
+		t.Error(err)
+	}
+	ret, _ = metDB.Get(m.Txid)
+	if ret.Label != "Payment for order abc" || ret.OrderId != m.OrderId {
+		t.Errorf("Expected the generated label, got %v", ret)
+	}
+
+	// The user can still replace a generated label
+	metDB.PutLabel(m.Txid, "Shirt")
+	ret, _ = metDB.Get(m.Txid)
+	if ret.Label != "Shirt" {
+		t.Error("User label did not replace the generated label")
+	}
+	metDB.Delete(m.Txid)
+	metDB.Delete("tx3")
+}
@@ -219,6 +219,15 @@ func addConfigExtensions(repoRoot string, testnet bool) error {
 	if err := extendConfigFile(r, "Tor-config", t); err != nil {
 		return err
 	}
+	if err := extendConfigFile(r, "EncryptOrderData", false); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "UnpaidOrderExpiryDays", DefaultUnpaidOrderExpiryDays); err != nil {
+		return err
+	}
+	if err := extendConfigFile(r, "Settlement-config", SettlementConfig{DefaultSettlementThreshold, DefaultSettlementMaxHours}); err != nil {
+		return err
+	}
 	if err := r.Close(); err != nil {
 		return err
 	}
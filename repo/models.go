@@ -17,6 +17,7 @@ type SettingsData struct {
 	BlockedNodes       *[]string          `json:"blockedNodes"`
 	StoreModerators    *[]string          `json:"storeModerators"`
 	MisPaymentBuffer   *float32           `json:"mispaymentBuffer"`
+	RotatePayoutAddrs  *bool              `json:"rotatePayoutAddresses"`
 	SMTPSettings       *SMTPSettings      `json:"smtpSettings"`
 	Version            *string            `json:"version"`
 }
@@ -79,6 +80,7 @@ type Metadata struct {
 	OrderId    string
 	Thumbnail  string
 	CanBumpFee bool
+	Label      string
 }
 
 type Purchase struct {